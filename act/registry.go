@@ -4,17 +4,24 @@ import (
 	"context"
 	"errors"
 	"slices"
+	"sort"
+	"sync"
 	"time"
 
 	sdkAct "github.com/gatewayd-io/gatewayd-plugin-sdk/act"
 	"github.com/gatewayd-io/gatewayd/config"
 	gerr "github.com/gatewayd-io/gatewayd/errors"
+	"github.com/gatewayd-io/gatewayd/events"
 	"github.com/rs/zerolog"
 )
 
 type IRegistry interface {
 	Add(policy *sdkAct.Policy)
+	Remove(name string) *gerr.GatewayDError
+	Policy(name string) *sdkAct.Policy
+	ListPolicies() []*sdkAct.Policy
 	Apply(signals []sdkAct.Signal) []*sdkAct.Output
+	Evaluate(signals []sdkAct.Signal, drafts ...*sdkAct.Policy) ([]*sdkAct.Output, *gerr.GatewayDError)
 	Run(output *sdkAct.Output, params ...sdkAct.Parameter) (any, *gerr.GatewayDError)
 }
 
@@ -32,6 +39,13 @@ type Registry struct {
 	DefaultPolicyName string
 	DefaultPolicy     *sdkAct.Policy
 	DefaultSignal     *sdkAct.Signal
+
+	// EventBus receives the policy matched events.
+	EventBus *events.Bus
+
+	// policiesMu guards the policies, since they can be changed at runtime
+	// while the signals are applied.
+	policiesMu *sync.RWMutex
 }
 
 var _ IRegistry = (*Registry)(nil)
@@ -88,6 +102,8 @@ func NewActRegistry(
 		Actions:              registry.Actions,
 		DefaultPolicy:        registry.Policies[registry.DefaultPolicyName],
 		DefaultSignal:        registry.Signals[registry.DefaultPolicyName],
+		EventBus:             registry.EventBus,
+		policiesMu:           &sync.RWMutex{},
 	}
 }
 
@@ -98,20 +114,155 @@ func (r *Registry) Add(policy *sdkAct.Policy) {
 		return
 	}
 
+	r.policiesMu.Lock()
+	defer r.policiesMu.Unlock()
+
 	if _, exists := r.Policies[policy.Name]; exists {
 		r.Logger.Warn().Str("name", policy.Name).Msg("Policy already exists, overwriting")
 	}
 
 	// Builtin policies are can be overwritten by user-defined policies.
+	r.set(policy)
+}
+
+// AddIfAbsent adds a policy to the registry, unless a policy with the same name exists.
+// The check and the addition are atomic.
+func (r *Registry) AddIfAbsent(policy *sdkAct.Policy) *gerr.GatewayDError {
+	if policy == nil {
+		return gerr.ErrNilPointer
+	}
+
+	r.policiesMu.Lock()
+	defer r.policiesMu.Unlock()
+
+	if _, exists := r.Policies[policy.Name]; exists {
+		return gerr.ErrPolicyAlreadyExists
+	}
+
+	r.set(policy)
+	return nil
+}
+
+// Replace replaces a policy of the registry, which must exist. The check and
+// the replacement are atomic.
+func (r *Registry) Replace(policy *sdkAct.Policy) *gerr.GatewayDError {
+	if policy == nil {
+		return gerr.ErrNilPointer
+	}
+
+	r.policiesMu.Lock()
+	defer r.policiesMu.Unlock()
+
+	if _, exists := r.Policies[policy.Name]; !exists {
+		return gerr.ErrPolicyNotExist
+	}
+
+	r.set(policy)
+	return nil
+}
+
+// set sets the policy, which also replaces the default policy if it has the same name.
+// The caller must hold the lock of the policies.
+func (r *Registry) set(policy *sdkAct.Policy) {
 	r.Policies[policy.Name] = policy
+	if r.DefaultPolicy != nil && r.DefaultPolicy.Name == policy.Name {
+		r.DefaultPolicy = policy
+	}
+}
+
+// Remove removes a policy from the registry. The default policy can't be removed,
+// since the signals fall back to it.
+func (r *Registry) Remove(name string) *gerr.GatewayDError {
+	r.policiesMu.Lock()
+	defer r.policiesMu.Unlock()
+
+	if _, exists := r.Policies[name]; !exists {
+		return gerr.ErrPolicyNotExist
+	}
+
+	if r.DefaultPolicy != nil && r.DefaultPolicy.Name == name {
+		return gerr.ErrValidationFailed.Wrap(errors.New("the default policy can't be removed"))
+	}
+
+	delete(r.Policies, name)
+	r.Logger.Debug().Str("name", name).Msg("Policy removed")
+	return nil
+}
+
+// Policy returns the policy with the given name, or nil if it doesn't exist.
+func (r *Registry) Policy(name string) *sdkAct.Policy {
+	r.policiesMu.RLock()
+	defer r.policiesMu.RUnlock()
+
+	return r.Policies[name]
+}
+
+// ListPolicies returns the policies sorted by name.
+func (r *Registry) ListPolicies() []*sdkAct.Policy {
+	r.policiesMu.RLock()
+	defer r.policiesMu.RUnlock()
+
+	policies := make([]*sdkAct.Policy, 0, len(r.Policies))
+	for _, policy := range r.Policies {
+		policies = append(policies, policy)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+	return policies
 }
 
 // Apply applies the signals to the registry and returns the outputs.
 func (r *Registry) Apply(signals []sdkAct.Signal) []*sdkAct.Output {
+	outputs, _ := r.applySignals(signals)
+	return outputs
+}
+
+// Evaluate applies the signals to the registry like Apply, without running any action,
+// and returns the outputs and the error of evaluating the policies, if any. The draft
+// policies are used instead of the policies with the same names, without adding them
+// to the registry, so that the policies can be tried before they are changed.
+func (r *Registry) Evaluate(
+	signals []sdkAct.Signal, drafts ...*sdkAct.Policy,
+) ([]*sdkAct.Output, *gerr.GatewayDError) {
+	if len(drafts) == 0 {
+		return r.applySignals(signals)
+	}
+
+	// The dry run has no event bus, so it doesn't publish the policy matched events.
+	r.policiesMu.RLock()
+	dryRun := &Registry{
+		Logger:               r.Logger,
+		PolicyTimeout:        r.PolicyTimeout,
+		DefaultActionTimeout: r.DefaultActionTimeout,
+		Signals:              r.Signals,
+		Policies:             make(map[string]*sdkAct.Policy, len(r.Policies)+len(drafts)),
+		Actions:              r.Actions,
+		DefaultPolicy:        r.DefaultPolicy,
+		DefaultSignal:        r.DefaultSignal,
+		policiesMu:           &sync.RWMutex{},
+	}
+	for name, policy := range r.Policies {
+		dryRun.Policies[name] = policy
+	}
+	r.policiesMu.RUnlock()
+
+	for _, draft := range drafts {
+		if draft != nil {
+			dryRun.Policies[draft.Name] = draft
+		}
+	}
+
+	return dryRun.applySignals(signals)
+}
+
+// applySignals applies the signals to the registry and returns the outputs,
+// and the last error of evaluating the policies, if any.
+func (r *Registry) applySignals(signals []sdkAct.Signal) ([]*sdkAct.Output, *gerr.GatewayDError) {
 	// If there are no signals, apply the default policy.
 	if len(signals) == 0 {
 		r.Logger.Debug().Msg("No signals provided, applying default signal")
-		return r.Apply([]sdkAct.Signal{*r.DefaultSignal})
+		return r.applySignals([]sdkAct.Signal{*r.DefaultSignal})
 	}
 
 	// Separate terminal and non-terminal signals to find contradictions.
@@ -127,7 +278,7 @@ func (r *Registry) Apply(signals []sdkAct.Signal) []*sdkAct.Output {
 	}
 
 	outputs := []*sdkAct.Output{}
-	evalErr := false
+	var evalErr *gerr.GatewayDError
 	for _, signal := range signals {
 		// Ignore contradictory actions (forward vs. terminate) if one of the signals is terminal.
 		// If the signal is terminal, all non-terminal signals are ignored. Also, it only
@@ -146,7 +297,7 @@ func (r *Registry) Apply(signals []sdkAct.Signal) []*sdkAct.Output {
 			// This also prevents stack overflows from infinite loops of the external
 			// if condition below.
 			if errors.Is(err, gerr.ErrEvalError) {
-				evalErr = true
+				evalErr = err
 			}
 			continue
 		}
@@ -154,11 +305,11 @@ func (r *Registry) Apply(signals []sdkAct.Signal) []*sdkAct.Output {
 		outputs = append(outputs, output)
 	}
 
-	if len(outputs) == 0 && !evalErr {
-		return r.Apply([]sdkAct.Signal{*r.DefaultSignal})
+	if len(outputs) == 0 && evalErr == nil {
+		return r.applySignals([]sdkAct.Signal{*r.DefaultSignal})
 	}
 
-	return outputs
+	return outputs, evalErr
 }
 
 // apply applies the signal to the registry and returns the output.
@@ -168,7 +319,9 @@ func (r *Registry) apply(signal sdkAct.Signal) (*sdkAct.Output, *gerr.GatewayDEr
 		return nil, gerr.ErrActionNotMatched
 	}
 
+	r.policiesMu.RLock()
 	policy, exists := r.Policies[action.Name]
+	r.policiesMu.RUnlock()
 	if !exists {
 		return nil, gerr.ErrPolicyNotMatched
 	}
@@ -192,6 +345,14 @@ func (r *Registry) apply(signal sdkAct.Signal) (*sdkAct.Output, *gerr.GatewayDEr
 		return nil, gerr.ErrEvalError.Wrap(err)
 	}
 
+	r.EventBus.Publish(events.PolicyMatched, "act", map[string]interface{}{
+		"signal":   signal.Name,
+		"policy":   policy.Name,
+		"verdict":  verdict,
+		"terminal": action.Terminal,
+		"sync":     action.Sync,
+	})
+
 	return &sdkAct.Output{
 		MatchedPolicy: policy.Name,
 		Verdict:       verdict,
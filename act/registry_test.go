@@ -2,12 +2,15 @@ package act
 
 import (
 	"bytes"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	sdkAct "github.com/gatewayd-io/gatewayd-plugin-sdk/act"
 	"github.com/gatewayd-io/gatewayd/config"
 	gerr "github.com/gatewayd-io/gatewayd/errors"
+	"github.com/gatewayd-io/gatewayd/events"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cast"
 	"github.com/stretchr/testify/assert"
@@ -182,6 +185,117 @@ func Test_Add_ExistentPolicy(t *testing.T) {
 	assert.Contains(t, buf.String(), "Policy already exists, overwriting")
 }
 
+// Test_AddIfAbsent_Replace tests that the policies are only added if they don't exist
+// and only replaced if they exist, even if the policy is added concurrently.
+func Test_AddIfAbsent_Replace(t *testing.T) {
+	actRegistry := NewActRegistry(
+		Registry{
+			Signals:              BuiltinSignals(),
+			Policies:             BuiltinPolicies(),
+			Actions:              BuiltinActions(),
+			DefaultPolicyName:    config.DefaultPolicy,
+			PolicyTimeout:        config.DefaultPolicyTimeout,
+			DefaultActionTimeout: config.DefaultActionTimeout,
+			Logger:               zerolog.Logger{},
+		})
+	assert.NotNil(t, actRegistry)
+
+	policy := sdkAct.MustNewPolicy("custom", "true", nil)
+	assert.ErrorIs(t, actRegistry.Replace(policy), gerr.ErrPolicyNotExist)
+	assert.ErrorIs(t, actRegistry.AddIfAbsent(nil), gerr.ErrNilPointer)
+
+	var added atomic.Int32
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if actRegistry.AddIfAbsent(sdkAct.MustNewPolicy("custom", "true", nil)) == nil {
+				added.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), added.Load())
+	assert.ErrorIs(t, actRegistry.AddIfAbsent(policy), gerr.ErrPolicyAlreadyExists)
+
+	require.Nil(t, actRegistry.Replace(policy))
+	assert.Equal(t, policy, actRegistry.Policy("custom"))
+
+	// Replacing the default policy also replaces the policy that the signals fall back to.
+	defaultPolicy := sdkAct.MustNewPolicy(config.DefaultPolicy, "false", nil)
+	require.Nil(t, actRegistry.Replace(defaultPolicy))
+	assert.Equal(t, defaultPolicy, actRegistry.DefaultPolicy)
+}
+
+// Test_Remove tests the Remove, Policy and ListPolicies functions of the act registry.
+func Test_Remove(t *testing.T) {
+	actRegistry := NewActRegistry(
+		Registry{
+			Signals:              BuiltinSignals(),
+			Policies:             BuiltinPolicies(),
+			Actions:              BuiltinActions(),
+			DefaultPolicyName:    config.DefaultPolicy,
+			PolicyTimeout:        config.DefaultPolicyTimeout,
+			DefaultActionTimeout: config.DefaultActionTimeout,
+			Logger:               zerolog.Logger{},
+		})
+	assert.NotNil(t, actRegistry)
+
+	policies := actRegistry.ListPolicies()
+	assert.Len(t, policies, len(BuiltinPolicies()))
+	assert.Equal(t, "log", policies[0].Name)
+	assert.NotNil(t, actRegistry.Policy("terminate"))
+
+	require.Nil(t, actRegistry.Remove("terminate"))
+	assert.Nil(t, actRegistry.Policy("terminate"))
+	assert.ErrorIs(t, actRegistry.Remove("terminate"), gerr.ErrPolicyNotExist)
+	assert.ErrorIs(t, actRegistry.Remove(config.DefaultPolicy), gerr.ErrValidationFailed)
+	assert.Len(t, actRegistry.ListPolicies(), len(BuiltinPolicies())-1)
+
+	// Replacing the default policy also replaces the policy that the signals fall back to.
+	policy := sdkAct.MustNewPolicy(config.DefaultPolicy, "false", nil)
+	actRegistry.Add(policy)
+	assert.Equal(t, policy, actRegistry.DefaultPolicy)
+}
+
+// Test_Evaluate tests the Evaluate function of the act registry with draft policies.
+func Test_Evaluate(t *testing.T) {
+	actRegistry := NewActRegistry(
+		Registry{
+			Signals:              BuiltinSignals(),
+			Policies:             BuiltinPolicies(),
+			Actions:              BuiltinActions(),
+			DefaultPolicyName:    config.DefaultPolicy,
+			PolicyTimeout:        config.DefaultPolicyTimeout,
+			DefaultActionTimeout: config.DefaultActionTimeout,
+			Logger:               zerolog.Logger{},
+		})
+	assert.NotNil(t, actRegistry)
+
+	signal := sdkAct.Signal{Name: "log", Metadata: map[string]any{"log": false}}
+	outputs, err := actRegistry.Evaluate([]sdkAct.Signal{signal})
+	require.Nil(t, err)
+	require.Len(t, outputs, 1)
+	assert.Equal(t, "log", outputs[0].MatchedPolicy)
+	assert.False(t, cast.ToBool(outputs[0].Verdict))
+
+	// The draft policy is used, but it isn't added to the registry.
+	draft := sdkAct.MustNewPolicy("log", "Signal.log == false", nil)
+	outputs, err = actRegistry.Evaluate([]sdkAct.Signal{signal}, draft)
+	require.Nil(t, err)
+	require.Len(t, outputs, 1)
+	assert.True(t, cast.ToBool(outputs[0].Verdict))
+	assert.NotEqual(t, draft, actRegistry.Policy("log"))
+
+	// The evaluation errors are returned.
+	draft = sdkAct.MustNewPolicy("log", "Signal.log + 'text'", nil)
+	require.NotNil(t, draft)
+	outputs, err = actRegistry.Evaluate([]sdkAct.Signal{signal}, draft)
+	assert.ErrorIs(t, err, gerr.ErrEvalError)
+	assert.Empty(t, outputs)
+}
+
 // Test_Apply tests the Apply function of the act registry.
 func Test_Apply(t *testing.T) {
 	actRegistry := NewActRegistry(
@@ -208,6 +322,38 @@ func Test_Apply(t *testing.T) {
 	assert.False(t, outputs[0].Terminal)
 }
 
+// Test_Apply_PolicyMatchedEvent tests that the matched policies are published to the event bus.
+func Test_Apply_PolicyMatchedEvent(t *testing.T) {
+	bus := events.NewBus(events.Bus{Logger: zerolog.Nop()})
+	matched, unsubscribe := bus.Subscribe(events.PolicyMatched)
+	defer unsubscribe()
+
+	actRegistry := NewActRegistry(
+		Registry{
+			Signals:              BuiltinSignals(),
+			Policies:             BuiltinPolicies(),
+			Actions:              BuiltinActions(),
+			DefaultPolicyName:    config.DefaultPolicy,
+			PolicyTimeout:        config.DefaultPolicyTimeout,
+			DefaultActionTimeout: config.DefaultActionTimeout,
+			Logger:               zerolog.Logger{},
+			EventBus:             bus,
+		})
+	require.NotNil(t, actRegistry)
+
+	actRegistry.Apply([]sdkAct.Signal{*sdkAct.Terminate()})
+	event := <-matched
+	assert.Equal(t, "terminate", event.Data["policy"])
+	assert.Equal(t, true, event.Data["verdict"])
+	assert.Equal(t, true, event.Data["terminal"])
+
+	// The dry runs don't publish any events.
+	_, err := actRegistry.Evaluate(
+		[]sdkAct.Signal{*sdkAct.Terminate()}, sdkAct.MustNewPolicy("terminate", "false", nil))
+	require.Nil(t, err)
+	assert.Empty(t, matched)
+}
+
 // Test_Apply_NoSignals tests the Apply function of the act registry with no signals.
 // It should apply the default policy.
 func Test_Apply_NoSignals(t *testing.T) {
@@ -0,0 +1,348 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	pluginV1 "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin/v1"
+	v1 "github.com/gatewayd-io/gatewayd/api/v1"
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/gatewayd-io/gatewayd/events"
+	"github.com/gatewayd-io/gatewayd/network"
+	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// AdminConsole runs the commands of the SQL admin console, which the administrators
+// use by connecting to the admin database of a server, e.g. with psql. The results
+// are built from the same data that the API returns. The clients log in with the name
+// and the token of an API credential with the admin role, and their commands are
+// logged in the audit log, like the calls to the admin methods of the API.
+type AdminConsole struct {
+	API *API
+}
+
+var _ network.IAdminConsole = (*AdminConsole)(nil)
+
+// adminCommands are the commands of the admin console and their descriptions.
+var adminCommands = [][]string{
+	{"SHOW HELP", "Show the commands of the admin console"},
+	{"SHOW POOLS", "Show the pools and the number of their server connections"},
+	{"SHOW CLIENTS", "Show the client connections"},
+	{"SHOW SERVERS", "Show the server connections"},
+	{"SHOW PLUGINS", "Show the loaded plugins"},
+	{"SHOW POLICIES", "Show the policies of the plugin hooks"},
+	{"PAUSE [pool]", "Hold the new queries of the pool, or all pools, at transaction boundaries"},
+	{"RESUME [pool]", "Let the held queries of the pool, or all pools, continue"},
+	{"RECONNECT [pool]", "Recycle the server connections of the pool, or all pools"},
+	{"RELOAD", "Reload the configuration files and apply the pool sizes"},
+}
+
+// Authenticate checks the password of the client, which must be the token of the API
+// credential with the name of the user and the admin role. The credentials are required
+// even if the authentication of the API is disabled, because the admin console is served
+// on the address of the server.
+func (c *AdminConsole) Authenticate(client network.AdminClient, password string) error {
+	err := errInvalidCredentials
+	for index := range c.API.Options.Auth.Credentials {
+		credential := &c.API.Options.Auth.Credentials[index]
+		if credential.Name == client.User &&
+			subtle.ConstantTimeCompare([]byte(credential.Token), []byte(password)) == 1 {
+			err = nil
+			if credential.GetRole() < config.RoleAdmin {
+				err = fmt.Errorf("%q is not allowed to use the admin console", client.User) //nolint:goerr113
+			}
+			break
+		}
+	}
+
+	c.audit(client, "", err)
+	return err
+}
+
+// Run runs an admin console command of an authenticated client and logs it
+// in the audit log. The keywords are case-insensitive.
+func (c *AdminConsole) Run(
+	ctx context.Context, client network.AdminClient, command string,
+) (*network.AdminResult, error) {
+	result, err := c.run(ctx, command)
+	c.audit(client, command, err)
+	return result, err
+}
+
+// run runs an admin console command.
+func (c *AdminConsole) run(ctx context.Context, command string) (*network.AdminResult, error) {
+	words := strings.Fields(command)
+	if len(words) == 0 {
+		return &network.AdminResult{}, nil
+	}
+	keywords := strings.ToUpper(strings.Join(words, " "))
+	verb, args := strings.ToUpper(words[0]), words[1:]
+
+	switch {
+	case keywords == "SHOW HELP":
+		return &network.AdminResult{
+			Columns: []string{"command", "description"},
+			Rows:    adminCommands,
+			Tag:     "SHOW",
+		}, nil
+	case keywords == "SHOW POOLS":
+		return c.showPools(ctx)
+	case keywords == "SHOW CLIENTS":
+		return c.showClients(ctx)
+	case keywords == "SHOW SERVERS":
+		return c.showServers(), nil
+	case keywords == "SHOW PLUGINS":
+		return c.showPlugins(ctx)
+	case keywords == "SHOW POLICIES":
+		return c.showPolicies(), nil
+	case verb == "PAUSE" && len(args) <= 1:
+		return c.operatePools(ctx, "PAUSE", args, c.API.PausePool)
+	case verb == "RESUME" && len(args) <= 1:
+		return c.operatePools(ctx, "RESUME", args, c.API.ResumePool)
+	case verb == "RECONNECT" && len(args) <= 1:
+		return c.operatePools(ctx, "RECONNECT", args, c.API.ReconnectPool)
+	case keywords == "RELOAD":
+		return c.reload(ctx)
+	}
+
+	return nil, fmt.Errorf( //nolint:goerr113
+		"unknown command %q, run SHOW HELP to see the commands", command)
+}
+
+// audit logs the commands of the admin console and the logins, whose command is empty.
+func (c *AdminConsole) audit(client network.AdminClient, command string, err error) {
+	fields := map[string]interface{}{
+		"caller":  client.User,
+		"address": client.Address,
+	}
+	if command != "" {
+		fields["command"] = command
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+
+	if command == "" {
+		event := c.API.Options.Logger.Info()
+		if err != nil {
+			// The failed logins are logged as warnings, like the denied API calls.
+			event = c.API.Options.Logger.Warn()
+		}
+		event.Fields(fields).Msg("Audit: admin console login")
+		return
+	}
+	c.API.Options.Logger.Info().Fields(fields).Msg("Audit: admin console command")
+}
+
+// showPools returns the pools and the number of their server connections.
+func (c *AdminConsole) showPools(ctx context.Context) (*network.AdminResult, error) {
+	result := &network.AdminResult{
+		Columns: []string{"name", "size", "available", "busy", "paused", "unheld"},
+		Tag:     "SHOW",
+	}
+
+	for _, name := range c.poolNames() {
+		proxy := c.API.Proxies[name]
+		result.Rows = append(result.Rows, []string{
+			name,
+			strconv.Itoa(proxy.AvailableConnections.Cap()),
+			strconv.Itoa(proxy.AvailableConnections.Size()),
+			strconv.Itoa(len(proxy.BusyConnectionsString())),
+			strconv.FormatBool(proxy.IsPaused()),
+			strconv.Itoa(len(proxy.UnheldConnections())),
+		})
+	}
+
+	return result, ctx.Err()
+}
+
+// showClients returns the client connections.
+func (c *AdminConsole) showClients(ctx context.Context) (*network.AdminResult, error) {
+	connections, err := c.API.ListConnections(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &network.AdminResult{
+		Columns: []string{
+			"id", "pool", "client_address", "backend_address", "tls", "user", "database",
+			"opened_at", "age", "bytes_in", "bytes_out", "state",
+		},
+		Tag: "SHOW",
+	}
+	for _, connection := range connections.GetConnections() {
+		result.Rows = append(result.Rows, []string{
+			strconv.FormatUint(connection.GetId(), 10),
+			connection.GetProxy(),
+			connection.GetClientAddress(),
+			connection.GetBackendAddress(),
+			strconv.FormatBool(connection.GetTls()),
+			connection.GetUser(),
+			connection.GetDatabase(),
+			connection.GetOpenedAt().AsTime().Format(time.RFC3339),
+			connection.GetAge().AsDuration().Round(time.Second).String(),
+			strconv.FormatInt(connection.GetBytesIn(), 10),
+			strconv.FormatInt(connection.GetBytesOut(), 10),
+			connection.GetState(),
+		})
+	}
+
+	return result, nil
+}
+
+// showServers returns the server connections of the pools and the clients that use them.
+func (c *AdminConsole) showServers() *network.AdminResult {
+	result := &network.AdminResult{
+		Columns: []string{"pool", "local_address", "remote_address", "state", "client_id"},
+		Tag:     "SHOW",
+	}
+
+	for _, name := range c.poolNames() {
+		proxy := c.API.Proxies[name]
+
+		var available [][]string
+		proxy.AvailableConnections.ForEach(func(_, value interface{}) bool {
+			if client, ok := value.(*network.Client); ok {
+				available = append(available, []string{
+					name, client.LocalAddr(), client.RemoteAddr(), "idle", "",
+				})
+			}
+			return true
+		})
+		slices.SortFunc(available, func(a, b []string) int {
+			return strings.Compare(a[1], b[1])
+		})
+		result.Rows = append(result.Rows, available...)
+
+		for _, connection := range proxy.Connections() {
+			result.Rows = append(result.Rows, []string{
+				name,
+				connection.BackendLocalAddress,
+				connection.BackendAddress,
+				"active",
+				strconv.FormatUint(connection.ID, 10),
+			})
+		}
+	}
+
+	return result
+}
+
+// showPlugins returns the loaded plugins.
+func (c *AdminConsole) showPlugins(ctx context.Context) (*network.AdminResult, error) {
+	plugins, err := c.API.GetPlugins(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &network.AdminResult{
+		Columns: []string{"name", "version", "remote_url", "hooks"},
+		Tag:     "SHOW",
+	}
+	for _, plugin := range plugins.GetConfigs() {
+		hooks := make([]string, 0, len(plugin.GetHooks()))
+		for _, hook := range plugin.GetHooks() {
+			hooks = append(hooks, pluginV1.HookName(hook).String())
+		}
+
+		result.Rows = append(result.Rows, []string{
+			plugin.GetId().GetName(),
+			plugin.GetId().GetVersion(),
+			plugin.GetId().GetRemoteUrl(),
+			strings.Join(hooks, ","),
+		})
+	}
+	slices.SortFunc(result.Rows, func(a, b []string) int {
+		return strings.Compare(a[0], b[0])
+	})
+
+	return result, nil
+}
+
+// showPolicies returns the policies of the plugin hooks.
+func (c *AdminConsole) showPolicies() *network.AdminResult {
+	result := &network.AdminResult{
+		Columns: []string{"name", "policy", "default"},
+		Tag:     "SHOW",
+	}
+
+	actRegistry := c.API.PluginRegistry.ActRegistry
+	for _, policy := range actRegistry.ListPolicies() {
+		result.Rows = append(result.Rows, []string{
+			policy.Name,
+			policy.Policy,
+			strconv.FormatBool(
+				actRegistry.DefaultPolicy != nil && actRegistry.DefaultPolicy.Name == policy.Name),
+		})
+	}
+
+	return result
+}
+
+// operatePools runs a pool operation of the API on the given pool, or on all the pools.
+func (c *AdminConsole) operatePools(
+	ctx context.Context,
+	tag string,
+	args []string,
+	operation func(context.Context, *v1.PoolName) (*v1.PoolStatus, error),
+) (*network.AdminResult, error) {
+	names := c.poolNames()
+	if len(args) == 1 {
+		names = args
+	}
+
+	for _, name := range names {
+		if _, err := operation(ctx, &v1.PoolName{Name: name}); err != nil {
+			// The clients show the message of the status, not its code.
+			return nil, errors.New(status.Convert(err).Message()) //nolint:goerr113
+		}
+	}
+
+	return &network.AdminResult{Tag: tag}, nil
+}
+
+// reload reloads the configuration files and resizes the pools whose size has changed,
+// which is the only pool setting that can be changed without restarting the GatewayD.
+func (c *AdminConsole) reload(ctx context.Context) (*network.AdminResult, error) {
+	conf, err := c.API.loadConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range c.poolNames() {
+		pool, ok := conf.Global.Pools[name]
+		if !ok || pool.Size == c.API.Proxies[name].AvailableConnections.Cap() {
+			continue
+		}
+
+		if _, err := c.API.ResizePool(ctx, &v1.ResizePoolRequest{
+			Name: name,
+			Size: int32(pool.Size),
+		}); err != nil {
+			return nil, errors.New(status.Convert(err).Message()) //nolint:goerr113
+		}
+	}
+
+	c.API.publish(events.ConfigReloaded, map[string]interface{}{
+		"globalConfigFile": c.API.Config.GlobalConfigFile,
+		"pluginConfigFile": c.API.Config.PluginConfigFile,
+	})
+
+	return &network.AdminResult{Tag: "RELOAD"}, nil
+}
+
+// poolNames returns the sorted names of the pools.
+func (c *AdminConsole) poolNames() []string {
+	names := maps.Keys(c.API.Proxies)
+	slices.Sort(names)
+	return names
+}
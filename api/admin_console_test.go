@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/gatewayd-io/gatewayd/network"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAdminConsole tests the commands of the admin console.
+func TestAdminConsole(t *testing.T) {
+	api := getAPIConfig()
+	var audit lockedBuffer
+	api.Options.Logger = zerolog.New(&audit)
+	console := &AdminConsole{API: api}
+	proxy := api.Proxies[config.Default]
+	client := network.AdminClient{User: "operator", Address: "127.0.0.1:5432"}
+
+	result, err := console.Run(context.Background(), client, "show help")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"command", "description"}, result.Columns)
+	assert.Len(t, result.Rows, len(adminCommands))
+
+	result, err = console.Run(context.Background(), client, "SHOW POOLS")
+	require.NoError(t, err)
+	assert.Equal(t, "SHOW", result.Tag)
+	assert.Equal(t, []string{"name", "size", "available", "busy", "paused", "unheld"}, result.Columns)
+	assert.Equal(t, [][]string{{config.Default, "10", "0", "0", "false", "0"}}, result.Rows)
+
+	result, err = console.Run(context.Background(), client, "SHOW POLICIES")
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Rows)
+	for _, row := range result.Rows {
+		assert.Equal(t, row[0] == config.DefaultPolicy, row[2] == "true")
+	}
+
+	result, err = console.Run(context.Background(), client, "SHOW CLIENTS")
+	require.NoError(t, err)
+	assert.Empty(t, result.Rows)
+
+	result, err = console.Run(context.Background(), client, "pause "+config.Default)
+	require.NoError(t, err)
+	assert.Equal(t, "PAUSE", result.Tag)
+	assert.Empty(t, result.Columns)
+	assert.True(t, proxy.IsPaused())
+
+	_, err = console.Run(context.Background(), client, "RESUME")
+	require.NoError(t, err)
+	assert.False(t, proxy.IsPaused())
+
+	_, err = console.Run(context.Background(), client, "PAUSE unknown")
+	assert.EqualError(t, err, `pool "unknown" not found`)
+	assert.False(t, proxy.IsPaused())
+
+	_, err = console.Run(context.Background(), client, "PAUSED")
+	assert.ErrorContains(t, err, "unknown command")
+	_, err = console.Run(context.Background(), client, "SELECT 1")
+	assert.ErrorContains(t, err, "unknown command")
+
+	// The commands are logged in the audit log.
+	assert.Contains(t, audit.String(), `"command":"pause default"`)
+	assert.Contains(t, audit.String(), `"caller":"operator"`)
+}
+
+// TestAdminConsoleAuthenticate tests that only the API credentials with the admin role
+// can log in to the admin console, and that the logins are logged in the audit log.
+func TestAdminConsoleAuthenticate(t *testing.T) {
+	api := getAPIConfig()
+	var audit lockedBuffer
+	api.Options.Logger = zerolog.New(&audit)
+	console := &AdminConsole{API: api}
+	operator := network.AdminClient{User: "operator", Address: "127.0.0.1:5432"}
+
+	// The credentials are required, even if the authentication of the API is disabled.
+	require.ErrorIs(t, console.Authenticate(operator, ""), errInvalidCredentials)
+
+	api.Options.Auth = config.APIAuth{Method: config.AuthMethodNone, Credentials: testCredentials}
+	require.NoError(t, console.Authenticate(operator, "operator-token"))
+	require.ErrorIs(t, console.Authenticate(operator, "reader-token"), errInvalidCredentials)
+	require.ErrorContains(t, console.Authenticate(
+		network.AdminClient{User: "reader"}, "reader-token"), "not allowed")
+
+	assert.Contains(t, audit.String(),
+		`{"level":"info","address":"127.0.0.1:5432","caller":"operator","message":"Audit: admin console login"}`)
+	assert.Contains(t, audit.String(), `{"level":"warn","address":"","caller":"reader"`)
+}
@@ -5,19 +5,30 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
 
+	sdkAct "github.com/gatewayd-io/gatewayd-plugin-sdk/act"
 	sdkPlugin "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin"
+	pluginV1 "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin/v1"
 	v1 "github.com/gatewayd-io/gatewayd/api/v1"
 	"github.com/gatewayd-io/gatewayd/config"
+	gerr "github.com/gatewayd-io/gatewayd/errors"
+	"github.com/gatewayd-io/gatewayd/events"
 	"github.com/gatewayd-io/gatewayd/metrics"
 	"github.com/gatewayd-io/gatewayd/network"
 	"github.com/gatewayd-io/gatewayd/plugin"
 	"github.com/gatewayd-io/gatewayd/pool"
 	"github.com/rs/zerolog"
+	"golang.org/x/exp/maps"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Options struct {
@@ -26,6 +37,18 @@ type Options struct {
 	GRPCAddress string
 	HTTPAddress string
 	Servers     map[string]*network.Server
+
+	// The TLS and the authentication settings of the gRPC and HTTP APIs.
+	EnableTLS    bool
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string
+	Auth         config.APIAuth
+
+	// EventBus is the source of the event stream, which is disabled if it is not set.
+	EventBus *events.Bus
+	// Readiness checks the readiness of the GatewayD. Only the liveness is checked if it is not set.
+	Readiness *ReadinessChecker
 }
 
 type API struct {
@@ -60,7 +83,10 @@ func (a *API) GetGlobalConfig(_ context.Context, group *v1.Group) (*structpb.Str
 	)
 
 	if group.GetGroupName() == "" {
-		jsonData, err = json.Marshal(a.Config.Global)
+		// The tokens of the API credentials are never returned.
+		globalConfig := a.Config.Global
+		globalConfig.API = globalConfig.API.Redacted()
+		jsonData, err = json.Marshal(globalConfig)
 	} else {
 		configGroup := a.Config.Global.Filter(group.GetGroupName())
 		if configGroup == nil {
@@ -134,36 +160,8 @@ func (a *API) GetPluginConfig(context.Context, *emptypb.Empty) (*structpb.Struct
 func (a *API) GetPlugins(context.Context, *emptypb.Empty) (*v1.PluginConfigs, error) {
 	plugins := make([]*v1.PluginConfig, 0)
 	a.PluginRegistry.ForEach(
-		func(pluginID sdkPlugin.Identifier, plugIn *plugin.Plugin) {
-			requires := make(map[string]string)
-			if plugIn.Requires != nil {
-				for _, r := range plugIn.Requires {
-					requires[r.Name] = r.Version
-				}
-			}
-
-			hooks := make([]int32, 0)
-			for _, hook := range plugIn.Hooks {
-				hooks = append(hooks, int32(hook.Number()))
-			}
-
-			plugins = append(plugins, &v1.PluginConfig{
-				Id: &v1.PluginID{
-					Name:      pluginID.Name,
-					Version:   pluginID.Version,
-					RemoteUrl: pluginID.RemoteURL,
-					Checksum:  pluginID.Checksum,
-				},
-				Description: plugIn.Description,
-				Authors:     plugIn.Authors,
-				License:     plugIn.License,
-				ProjectUrl:  plugIn.ProjectURL,
-				Config:      plugIn.Config,
-				Hooks:       hooks,
-				Requires:    requires,
-				Tags:        plugIn.Tags,
-				Categories:  plugIn.Categories,
-			})
+		func(_ sdkPlugin.Identifier, plugIn *plugin.Plugin) {
+			plugins = append(plugins, a.pluginConfig(plugIn))
 		},
 	)
 
@@ -173,6 +171,36 @@ func (a *API) GetPlugins(context.Context, *emptypb.Empty) (*v1.PluginConfigs, er
 	}, nil
 }
 
+// GetHookChains returns the hooks of each type in the order they are run, so that the
+// effect of the plugin and the hook priorities can be checked.
+func (a *API) GetHookChains(context.Context, *emptypb.Empty) (*v1.HookChains, error) {
+	chains := a.PluginRegistry.HookChains()
+	hookNames := make([]pluginV1.HookName, 0, len(chains))
+	for hookName := range chains {
+		hookNames = append(hookNames, hookName)
+	}
+	slices.Sort(hookNames)
+
+	hookChains := make([]*v1.HookChain, 0, len(hookNames))
+	for _, hookName := range hookNames {
+		hooks := make([]*v1.ChainedHook, 0, len(chains[hookName]))
+		for _, hook := range chains[hookName] {
+			hooks = append(hooks, &v1.ChainedHook{
+				Plugin:   hook.Plugin,
+				Priority: uint32(hook.Priority),
+			})
+		}
+		hookChains = append(hookChains, &v1.HookChain{
+			HookName: hookName.String(),
+			Async:    a.PluginRegistry.IsAsync(hookName),
+			Hooks:    hooks,
+		})
+	}
+
+	metrics.APIRequests.WithLabelValues("GET", "/v1/GatewayDPluginService/GetHookChains").Inc()
+	return &v1.HookChains{Chains: hookChains}, nil
+}
+
 // GetPools returns the pool configuration of the GatewayD.
 func (a *API) GetPools(context.Context, *emptypb.Empty) (*structpb.Struct, error) {
 	pools := make(map[string]interface{})
@@ -251,3 +279,526 @@ func (a *API) GetServers(context.Context, *emptypb.Empty) (*structpb.Struct, err
 	metrics.APIRequests.WithLabelValues("GET", "/v1/GatewayDPluginService/GetServers").Inc()
 	return serversConfig, nil
 }
+
+// ListConnections returns the client connections of all the proxies.
+func (a *API) ListConnections(context.Context, *emptypb.Empty) (*v1.Connections, error) {
+	names := maps.Keys(a.Proxies)
+	slices.Sort(names)
+
+	connections := make([]*v1.Connection, 0)
+	for _, name := range names {
+		for _, info := range a.Proxies[name].Connections() {
+			connections = append(connections, &v1.Connection{
+				Id:                  info.ID,
+				Proxy:               name,
+				ClientAddress:       info.ClientAddress,
+				BackendAddress:      info.BackendAddress,
+				BackendLocalAddress: info.BackendLocalAddress,
+				Tls:                 info.TLS,
+				User:                info.User,
+				Database:            info.Database,
+				OpenedAt:            timestamppb.New(info.OpenedAt),
+				Age:                 durationpb.New(time.Since(info.OpenedAt)),
+				BytesIn:             info.BytesIn,
+				BytesOut:            info.BytesOut,
+				State:               info.State,
+			})
+		}
+	}
+
+	metrics.APIRequests.WithLabelValues("GET", "/v1/GatewayDPluginService/ListConnections").Inc()
+	return &v1.Connections{Connections: connections}, nil
+}
+
+// KillConnection terminates the given client connection.
+func (a *API) KillConnection(_ context.Context, id *v1.ConnectionID) (*emptypb.Empty, error) {
+	for _, proxy := range a.Proxies {
+		err := proxy.KillConnection(id.GetId())
+		if err == nil {
+			metrics.APIRequests.WithLabelValues(
+				"POST", "/v1/GatewayDPluginService/KillConnection").Inc()
+			return &emptypb.Empty{}, nil
+		}
+		if !errors.Is(err, gerr.ErrConnectionNotFound) {
+			metrics.APIRequestsErrors.WithLabelValues(
+				"POST", "/v1/GatewayDPluginService/KillConnection", codes.Internal.String(),
+			).Inc()
+			return nil, status.Errorf(codes.Internal, "failed to kill connection: %v", err)
+		}
+	}
+
+	metrics.APIRequestsErrors.WithLabelValues(
+		"POST", "/v1/GatewayDPluginService/KillConnection", codes.NotFound.String(),
+	).Inc()
+	return nil, status.Errorf(codes.NotFound, "connection %d not found", id.GetId())
+}
+
+// PausePool holds the new queries of the given pool at transaction boundaries.
+func (a *API) PausePool(_ context.Context, name *v1.PoolName) (*v1.PoolStatus, error) {
+	return a.operatePool(name.GetName(), "PausePool", func(proxy *network.Proxy) *gerr.GatewayDError {
+		proxy.Pause()
+		return nil
+	})
+}
+
+// ResumePool lets the held queries of the given pool continue.
+func (a *API) ResumePool(_ context.Context, name *v1.PoolName) (*v1.PoolStatus, error) {
+	return a.operatePool(name.GetName(), "ResumePool", func(proxy *network.Proxy) *gerr.GatewayDError {
+		proxy.Resume()
+		return nil
+	})
+}
+
+// ReconnectPool recycles the server connections of the given pool gracefully.
+func (a *API) ReconnectPool(_ context.Context, name *v1.PoolName) (*v1.PoolStatus, error) {
+	return a.operatePool(name.GetName(), "ReconnectPool", func(proxy *network.Proxy) *gerr.GatewayDError {
+		return proxy.Reconnect()
+	})
+}
+
+// ResizePool changes the number of server connections of the given pool.
+func (a *API) ResizePool(_ context.Context, req *v1.ResizePoolRequest) (*v1.PoolStatus, error) {
+	return a.operatePool(req.GetName(), "ResizePool", func(proxy *network.Proxy) *gerr.GatewayDError {
+		return proxy.Resize(int(req.GetSize()))
+	})
+}
+
+// operatePool runs the operation on the proxy of the given pool and returns the pool status.
+func (a *API) operatePool(
+	name, method string, operation func(proxy *network.Proxy) *gerr.GatewayDError,
+) (*v1.PoolStatus, error) {
+	path := "/v1/GatewayDPluginService/" + method
+
+	proxy, ok := a.Proxies[name]
+	if !ok {
+		metrics.APIRequestsErrors.WithLabelValues("POST", path, codes.NotFound.String()).Inc()
+		return nil, status.Errorf(codes.NotFound, "pool %q not found", name)
+	}
+
+	if err := operation(proxy); err != nil {
+		code := codes.Internal
+		if errors.Is(err, gerr.ErrValidationFailed) {
+			code = codes.InvalidArgument
+		}
+		metrics.APIRequestsErrors.WithLabelValues("POST", path, code.String()).Inc()
+		return nil, status.Errorf(code, "failed to run %s: %v", method, err)
+	}
+
+	metrics.APIRequests.WithLabelValues("POST", path).Inc()
+	return &v1.PoolStatus{
+		Name:      name,
+		Size:      int32(proxy.AvailableConnections.Cap()),
+		Available: int32(proxy.AvailableConnections.Size()),
+		Busy:      int32(len(proxy.BusyConnectionsString())),
+		Paused:    proxy.IsPaused(),
+		Unheld:    proxy.UnheldConnections(),
+	}, nil
+}
+
+// LoadPlugin loads the given plugin from the plugins configuration file.
+// The priority of the plugin is determined by its position in the file.
+func (a *API) LoadPlugin(ctx context.Context, name *v1.PluginName) (*v1.PluginConfig, error) {
+	path := "/v1/GatewayDPluginService/LoadPlugin"
+
+	plugIn, err := a.loadPlugin(ctx, name.GetName())
+	if err != nil {
+		metrics.APIRequestsErrors.WithLabelValues("POST", path, status.Code(err).String()).Inc()
+		return nil, err
+	}
+
+	metrics.APIRequests.WithLabelValues("POST", path).Inc()
+	return a.pluginConfig(plugIn), nil
+}
+
+// UnloadPlugin removes the hooks of the given plugin and stops it,
+// once the running calls to its hooks have returned.
+func (a *API) UnloadPlugin(_ context.Context, name *v1.PluginName) (*emptypb.Empty, error) {
+	path := "/v1/GatewayDPluginService/UnloadPlugin"
+
+	plugIn := a.findPlugin(name.GetName())
+	if plugIn == nil {
+		metrics.APIRequestsErrors.WithLabelValues("POST", path, codes.NotFound.String()).Inc()
+		return nil, status.Errorf(codes.NotFound, "plugin %q not found", name.GetName())
+	}
+	if plugIn.IsBuiltin() {
+		metrics.APIRequestsErrors.WithLabelValues(
+			"POST", path, codes.FailedPrecondition.String()).Inc()
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"plugin %q is built into GatewayD, so its hooks can only be disabled", name.GetName())
+	}
+
+	a.PluginRegistry.UnloadPlugin(plugIn.ID)
+
+	metrics.APIRequests.WithLabelValues("POST", path).Inc()
+	return &emptypb.Empty{}, nil
+}
+
+// ReloadPlugin unloads the given plugin and loads it again from the plugins configuration
+// file, e.g. after its binary is updated. The traffic isn't passed to the plugin while it
+// is reloaded.
+func (a *API) ReloadPlugin(ctx context.Context, name *v1.PluginName) (*v1.PluginConfig, error) {
+	path := "/v1/GatewayDPluginService/ReloadPlugin"
+
+	plugIn := a.findPlugin(name.GetName())
+	if plugIn == nil {
+		metrics.APIRequestsErrors.WithLabelValues("POST", path, codes.NotFound.String()).Inc()
+		return nil, status.Errorf(codes.NotFound, "plugin %q not found", name.GetName())
+	}
+	if plugIn.IsBuiltin() {
+		metrics.APIRequestsErrors.WithLabelValues(
+			"POST", path, codes.FailedPrecondition.String()).Inc()
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"plugin %q is built into GatewayD, so its hooks can only be disabled", name.GetName())
+	}
+
+	a.PluginRegistry.UnloadPlugin(plugIn.ID)
+
+	plugIn, err := a.loadPlugin(ctx, name.GetName())
+	if err != nil {
+		metrics.APIRequestsErrors.WithLabelValues("POST", path, status.Code(err).String()).Inc()
+		return nil, err
+	}
+
+	a.publish(events.PluginReloaded, map[string]interface{}{
+		"name":    plugIn.ID.Name,
+		"version": plugIn.ID.Version,
+	})
+
+	metrics.APIRequests.WithLabelValues("POST", path).Inc()
+	return a.pluginConfig(plugIn), nil
+}
+
+// EnablePluginHooks registers the given hooks of the plugin, or all of its hooks.
+func (a *API) EnablePluginHooks(ctx context.Context, req *v1.PluginHooks) (*v1.PluginConfig, error) {
+	return a.operatePluginHooks(req, "EnablePluginHooks",
+		func(plugIn *plugin.Plugin, hookNames []pluginV1.HookName) {
+			a.PluginRegistry.RegisterHooks(ctx, plugIn.ID, hookNames...)
+		})
+}
+
+// DisablePluginHooks removes the given hooks of the plugin, or all of its hooks,
+// and waits for the running calls to them to return.
+func (a *API) DisablePluginHooks(_ context.Context, req *v1.PluginHooks) (*v1.PluginConfig, error) {
+	return a.operatePluginHooks(req, "DisablePluginHooks",
+		func(plugIn *plugin.Plugin, hookNames []pluginV1.HookName) {
+			a.PluginRegistry.UnregisterHooks(plugIn.ID, hookNames...)
+		})
+}
+
+// operatePluginHooks runs the operation on the hooks of the given plugin and returns
+// the plugin configuration. The hooks must be declared by the plugin.
+func (a *API) operatePluginHooks(
+	req *v1.PluginHooks,
+	method string,
+	operation func(plugIn *plugin.Plugin, hookNames []pluginV1.HookName),
+) (*v1.PluginConfig, error) {
+	path := "/v1/GatewayDPluginService/" + method
+
+	plugIn := a.findPlugin(req.GetName())
+	if plugIn == nil {
+		metrics.APIRequestsErrors.WithLabelValues("POST", path, codes.NotFound.String()).Inc()
+		return nil, status.Errorf(codes.NotFound, "plugin %q not found", req.GetName())
+	}
+
+	hookNames := make([]pluginV1.HookName, 0, len(req.GetHooks()))
+	for _, hook := range req.GetHooks() {
+		hookName, ok := pluginV1.HookName_value[hook]
+		if !ok || !slices.Contains(plugIn.Hooks, pluginV1.HookName(hookName)) {
+			metrics.APIRequestsErrors.WithLabelValues(
+				"POST", path, codes.InvalidArgument.String()).Inc()
+			return nil, status.Errorf(
+				codes.InvalidArgument, "plugin %q doesn't have the hook %q", req.GetName(), hook)
+		}
+		hookNames = append(hookNames, pluginV1.HookName(hookName))
+	}
+
+	operation(plugIn, hookNames)
+
+	metrics.APIRequests.WithLabelValues("POST", path).Inc()
+	return a.pluginConfig(plugIn), nil
+}
+
+// loadPlugin loads the given plugin from the plugins configuration file
+// and returns it, or a gRPC status error.
+func (a *API) loadPlugin(ctx context.Context, name string) (*plugin.Plugin, error) {
+	conf, err := a.loadConfig(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load the config: %v", err)
+	}
+
+	pluginConfig, priority, ok := plugin.FindPluginConfig(conf.Plugin.Plugins, name)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "plugin %q is not in the config", name)
+	}
+
+	if err := a.PluginRegistry.LoadPlugin(
+		ctx, pluginConfig, priority, conf.Plugin.StartTimeout,
+	); err != nil {
+		if errors.Is(err, gerr.ErrPluginAlreadyLoaded) {
+			return nil, status.Errorf(codes.AlreadyExists, "failed to load plugin %q: %v", name, err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to load plugin %q: %v", name, err)
+	}
+
+	plugIn := a.findPlugin(name)
+	if plugIn == nil {
+		return nil, status.Errorf(codes.Internal, "plugin %q isn't in the registry", name)
+	}
+	return plugIn, nil
+}
+
+// loadConfig reads the configuration files again, so that the changes
+// made to them since the GatewayD started can be applied.
+func (a *API) loadConfig(ctx context.Context) (*config.Config, *gerr.GatewayDError) {
+	conf := config.NewConfig(ctx, config.Config{
+		GlobalConfigFile: a.Config.GlobalConfigFile,
+		PluginConfigFile: a.Config.PluginConfigFile,
+	})
+	if err := conf.InitConfig(ctx); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+// findPlugin returns the loaded plugin with the given name, or nil.
+func (a *API) findPlugin(name string) *plugin.Plugin {
+	var found *plugin.Plugin
+	a.PluginRegistry.ForEach(func(pluginID sdkPlugin.Identifier, plugIn *plugin.Plugin) {
+		if pluginID.Name == name {
+			found = plugIn
+		}
+	})
+	return found
+}
+
+// pluginConfig returns the configuration of the plugin, including its disabled hooks.
+func (a *API) pluginConfig(plugIn *plugin.Plugin) *v1.PluginConfig {
+	requires := make(map[string]string)
+	if plugIn.Requires != nil {
+		for _, r := range plugIn.Requires {
+			requires[r.Name] = r.Version
+		}
+	}
+
+	registered := a.PluginRegistry.RegisteredHooks(plugIn.ID)
+	hooks := make([]int32, 0)
+	disabledHooks := make([]int32, 0)
+	for _, hook := range plugIn.Hooks {
+		hooks = append(hooks, int32(hook.Number()))
+		if !slices.Contains(registered, hook) {
+			disabledHooks = append(disabledHooks, int32(hook.Number()))
+		}
+	}
+
+	var circuitBreaker *v1.CircuitBreaker
+	breaker, failureMode := a.PluginRegistry.CircuitBreaker(plugIn.ID)
+	if breaker != nil {
+		state, failures := breaker.State()
+		circuitBreaker = &v1.CircuitBreaker{
+			State:            string(state),
+			Failures:         int32(failures),
+			FailureThreshold: int32(breaker.FailureThreshold),
+			CoolDown:         breaker.CoolDown.String(),
+		}
+	}
+
+	return &v1.PluginConfig{
+		Id: &v1.PluginID{
+			Name:      plugIn.ID.Name,
+			Version:   plugIn.ID.Version,
+			RemoteUrl: plugIn.ID.RemoteURL,
+			Checksum:  plugIn.ID.Checksum,
+		},
+		Description:    plugIn.Description,
+		Authors:        plugIn.Authors,
+		License:        plugIn.License,
+		ProjectUrl:     plugIn.ProjectURL,
+		Config:         plugIn.Config,
+		Hooks:          hooks,
+		Requires:       requires,
+		Tags:           plugIn.Tags,
+		Categories:     plugIn.Categories,
+		DisabledHooks:  disabledHooks,
+		FailureMode:    string(failureMode),
+		CircuitBreaker: circuitBreaker,
+		Builtin:        plugIn.IsBuiltin(),
+	}
+}
+
+// GetPolicies returns the policies of the act registry.
+func (a *API) GetPolicies(context.Context, *emptypb.Empty) (*v1.Policies, error) {
+	actRegistry := a.PluginRegistry.ActRegistry
+
+	policies := make([]*v1.Policy, 0)
+	for _, policy := range actRegistry.ListPolicies() {
+		policyProto, err := policyToProto(policy)
+		if err != nil {
+			metrics.APIRequestsErrors.WithLabelValues(
+				"GET", "/v1/GatewayDPluginService/GetPolicies", codes.Internal.String(),
+			).Inc()
+			return nil, status.Errorf(codes.Internal, "failed to marshal policy %q: %v", policy.Name, err)
+		}
+		policies = append(policies, policyProto)
+	}
+
+	var defaultPolicy string
+	if actRegistry.DefaultPolicy != nil {
+		defaultPolicy = actRegistry.DefaultPolicy.Name
+	}
+
+	metrics.APIRequests.WithLabelValues("GET", "/v1/GatewayDPluginService/GetPolicies").Inc()
+	return &v1.Policies{Policies: policies, DefaultPolicy: defaultPolicy}, nil
+}
+
+// AddPolicy adds a new policy to the act registry.
+func (a *API) AddPolicy(_ context.Context, req *v1.Policy) (*v1.Policy, error) {
+	path := "/v1/GatewayDPluginService/AddPolicy"
+
+	policy, err := newPolicy(req)
+	if err != nil {
+		metrics.APIRequestsErrors.WithLabelValues("POST", path, codes.InvalidArgument.String()).Inc()
+		return nil, status.Errorf(codes.InvalidArgument, "invalid policy: %v", err)
+	}
+
+	if err := a.PluginRegistry.ActRegistry.AddIfAbsent(policy); err != nil {
+		code := codes.Internal
+		if errors.Is(err, gerr.ErrPolicyAlreadyExists) {
+			code = codes.AlreadyExists
+		}
+		metrics.APIRequestsErrors.WithLabelValues("POST", path, code.String()).Inc()
+		return nil, status.Errorf(code, "failed to add policy %q: %v", policy.Name, err)
+	}
+
+	metrics.APIRequests.WithLabelValues("POST", path).Inc()
+	return req, nil
+}
+
+// UpdatePolicy replaces a policy of the act registry.
+func (a *API) UpdatePolicy(_ context.Context, req *v1.Policy) (*v1.Policy, error) {
+	path := "/v1/GatewayDPluginService/UpdatePolicy"
+
+	policy, err := newPolicy(req)
+	if err != nil {
+		metrics.APIRequestsErrors.WithLabelValues("POST", path, codes.InvalidArgument.String()).Inc()
+		return nil, status.Errorf(codes.InvalidArgument, "invalid policy: %v", err)
+	}
+
+	if err := a.PluginRegistry.ActRegistry.Replace(policy); err != nil {
+		code := codes.Internal
+		if errors.Is(err, gerr.ErrPolicyNotExist) {
+			code = codes.NotFound
+		}
+		metrics.APIRequestsErrors.WithLabelValues("POST", path, code.String()).Inc()
+		return nil, status.Errorf(code, "failed to update policy %q: %v", policy.Name, err)
+	}
+
+	metrics.APIRequests.WithLabelValues("POST", path).Inc()
+	return req, nil
+}
+
+// DeletePolicy removes a policy from the act registry.
+func (a *API) DeletePolicy(_ context.Context, name *v1.PolicyName) (*emptypb.Empty, error) {
+	path := "/v1/GatewayDPluginService/DeletePolicy"
+
+	if err := a.PluginRegistry.ActRegistry.Remove(name.GetName()); err != nil {
+		code := codes.Internal
+		switch {
+		case errors.Is(err, gerr.ErrPolicyNotExist):
+			code = codes.NotFound
+		case errors.Is(err, gerr.ErrValidationFailed):
+			code = codes.FailedPrecondition
+		}
+		metrics.APIRequestsErrors.WithLabelValues("POST", path, code.String()).Inc()
+		return nil, status.Errorf(code, "failed to delete policy %q: %v", name.GetName(), err)
+	}
+
+	metrics.APIRequests.WithLabelValues("POST", path).Inc()
+	return &emptypb.Empty{}, nil
+}
+
+// EvaluatePolicy evaluates the policies for the given signals, and the draft policy if
+// it is given, and returns the outputs without running the actions.
+func (a *API) EvaluatePolicy(
+	_ context.Context, req *v1.EvaluatePolicyRequest,
+) (*v1.EvaluatePolicyResponse, error) {
+	path := "/v1/GatewayDPluginService/EvaluatePolicy"
+
+	var drafts []*sdkAct.Policy
+	if req.GetPolicy() != nil {
+		draft, err := newPolicy(req.GetPolicy())
+		if err != nil {
+			metrics.APIRequestsErrors.WithLabelValues("POST", path, codes.InvalidArgument.String()).Inc()
+			return nil, status.Errorf(codes.InvalidArgument, "invalid policy: %v", err)
+		}
+		drafts = append(drafts, draft)
+	}
+
+	signals := make([]sdkAct.Signal, 0, len(req.GetSignals()))
+	for _, signal := range req.GetSignals() {
+		signals = append(signals, sdkAct.Signal{
+			Name:     signal.GetName(),
+			Metadata: signal.GetMetadata().AsMap(),
+		})
+	}
+
+	outputs, evalErr := a.PluginRegistry.ActRegistry.Evaluate(signals, drafts...)
+	if evalErr != nil {
+		metrics.APIRequestsErrors.WithLabelValues("POST", path, codes.InvalidArgument.String()).Inc()
+		return nil, status.Errorf(codes.InvalidArgument, "failed to evaluate policy: %v", evalErr)
+	}
+
+	response := &v1.EvaluatePolicyResponse{Outputs: make([]*v1.PolicyOutput, 0, len(outputs))}
+	for _, output := range outputs {
+		verdict, err := structpb.NewValue(output.Verdict)
+		if err != nil {
+			// The verdicts of the policies are usually booleans, but they can be any value.
+			verdict = structpb.NewStringValue(fmt.Sprint(output.Verdict))
+		}
+		metadata, err := structpb.NewStruct(output.Metadata)
+		if err != nil {
+			metrics.APIRequestsErrors.WithLabelValues("POST", path, codes.Internal.String()).Inc()
+			return nil, status.Errorf(codes.Internal, "failed to marshal signal metadata: %v", err)
+		}
+
+		response.Outputs = append(response.GetOutputs(), &v1.PolicyOutput{
+			MatchedPolicy: output.MatchedPolicy,
+			Verdict:       verdict,
+			Metadata:      metadata,
+			Terminal:      output.Terminal,
+			Sync:          output.Sync,
+		})
+	}
+
+	metrics.APIRequests.WithLabelValues("POST", path).Inc()
+	return response, nil
+}
+
+// newPolicy creates a policy from the request and compiles its expression.
+func newPolicy(req *v1.Policy) (*sdkAct.Policy, error) {
+	if req.GetName() == "" {
+		return nil, errors.New("the name is empty") //nolint:goerr113
+	}
+
+	policy, err := sdkAct.NewPolicy(req.GetName(), req.GetPolicy(), req.GetMetadata().AsMap())
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the expression: %w", err)
+	}
+	return policy, nil
+}
+
+// policyToProto converts a policy of the act registry to its API representation.
+func policyToProto(policy *sdkAct.Policy) (*v1.Policy, error) {
+	var metadata *structpb.Struct
+	if policy.Metadata != nil {
+		var err error
+		if metadata, err = structpb.NewStruct(policy.Metadata); err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+	}
+
+	return &v1.Policy{
+		Name:     policy.Name,
+		Policy:   policy.Policy,
+		Metadata: metadata,
+	}, nil
+}
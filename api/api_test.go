@@ -16,7 +16,11 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestGetVersion(t *testing.T) {
@@ -172,6 +176,49 @@ func TestGetPlugins(t *testing.T) {
 		plugins.GetConfigs()[0].GetHooks()[0])
 }
 
+// TestGetHookChains tests that the hooks of each type are returned in the order they run.
+func TestGetHookChains(t *testing.T) {
+	pluginRegistry := plugin.NewRegistry(
+		context.TODO(),
+		plugin.Registry{
+			Compatibility: config.Loose,
+			Logger:        zerolog.Logger{},
+			DevMode:       true,
+			AsyncHooks:    []pluginV1.HookName{pluginV1.HookName_HOOK_NAME_ON_OPENED},
+		},
+	)
+
+	for index, name := range []string{"first", "second"} {
+		pluginRegistry.Add(&plugin.Plugin{
+			ID:       sdkPlugin.Identifier{Name: name},
+			Priority: plugin.Priority(index),
+		})
+	}
+	hook := func(_ context.Context, args *pluginV1.Struct, _ ...grpc.CallOption) (*pluginV1.Struct, error) {
+		return args, nil
+	}
+	pluginRegistry.AddHook(pluginV1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT, plugin.Priority(1), hook)
+	pluginRegistry.AddHook(pluginV1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT, plugin.Priority(0), hook)
+	pluginRegistry.AddHook(pluginV1.HookName_HOOK_NAME_ON_OPENED, plugin.Priority(0), hook)
+
+	api := API{PluginRegistry: pluginRegistry}
+	chains, err := api.GetHookChains(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Len(t, chains.GetChains(), 2)
+
+	opened := chains.GetChains()[0]
+	assert.Equal(t, pluginV1.HookName_HOOK_NAME_ON_OPENED.String(), opened.GetHookName())
+	assert.True(t, opened.GetAsync())
+
+	fromClient := chains.GetChains()[1]
+	assert.Equal(t, pluginV1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT.String(), fromClient.GetHookName())
+	assert.False(t, fromClient.GetAsync())
+	require.Len(t, fromClient.GetHooks(), 2)
+	assert.Equal(t, "first", fromClient.GetHooks()[0].GetPlugin())
+	assert.Equal(t, uint32(config.PluginPriorityStart), fromClient.GetHooks()[0].GetPriority())
+	assert.Equal(t, "second", fromClient.GetHooks()[1].GetPlugin())
+}
+
 func TestGetPluginsWithEmptyPluginRegistry(t *testing.T) {
 	actRegistry := act.NewActRegistry(
 		act.Registry{
@@ -361,3 +408,152 @@ func TestGetServers(t *testing.T) {
 		t.Errorf("servers.default is not found or not a map")
 	}
 }
+
+func TestListConnections(t *testing.T) {
+	api := getAPIConfig()
+	connections, err := api.ListConnections(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Empty(t, connections.GetConnections())
+}
+
+func TestKillConnectionNotFound(t *testing.T) {
+	api := getAPIConfig()
+	_, err := api.KillConnection(context.Background(), &v1.ConnectionID{Id: 1})
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPoolOperations(t *testing.T) {
+	api := getAPIConfig()
+
+	poolStatus, err := api.PausePool(context.Background(), &v1.PoolName{Name: config.Default})
+	require.NoError(t, err)
+	assert.Equal(t, config.Default, poolStatus.GetName())
+	assert.Equal(t, int32(config.DefaultPoolSize), poolStatus.GetSize())
+	assert.True(t, poolStatus.GetPaused())
+	assert.Empty(t, poolStatus.GetUnheld())
+
+	poolStatus, err = api.ResumePool(context.Background(), &v1.PoolName{Name: config.Default})
+	require.NoError(t, err)
+	assert.False(t, poolStatus.GetPaused())
+
+	poolStatus, err = api.ReconnectPool(context.Background(), &v1.PoolName{Name: config.Default})
+	require.NoError(t, err)
+	assert.Zero(t, poolStatus.GetAvailable())
+	assert.Zero(t, poolStatus.GetBusy())
+
+	_, err = api.ResizePool(
+		context.Background(), &v1.ResizePoolRequest{Name: config.Default, Size: 0})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = api.PausePool(context.Background(), &v1.PoolName{Name: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPluginOperations(t *testing.T) {
+	api := getAPIConfig()
+	impl := &plugin.Plugin{
+		ID:       sdkPlugin.Identifier{Name: "plugin-name"},
+		Priority: 1000,
+		Hooks: []pluginV1.HookName{
+			pluginV1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT,
+			pluginV1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_SERVER,
+		},
+	}
+	api.PluginRegistry.Add(impl)
+	for _, hook := range impl.Hooks {
+		api.PluginRegistry.AddHook(hook, impl.Priority, func(
+			_ context.Context, args *pluginV1.Struct, _ ...grpc.CallOption,
+		) (*pluginV1.Struct, error) {
+			return args, nil
+		})
+	}
+
+	pluginConfig, err := api.DisablePluginHooks(context.Background(), &v1.PluginHooks{
+		Name:  "plugin-name",
+		Hooks: []string{"HOOK_NAME_ON_TRAFFIC_FROM_SERVER"},
+	})
+	require.NoError(t, err)
+	assert.Len(t, pluginConfig.GetHooks(), 2)
+	assert.Equal(t,
+		[]int32{int32(pluginV1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_SERVER)},
+		pluginConfig.GetDisabledHooks())
+
+	plugins, err := api.GetPlugins(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, pluginConfig.GetDisabledHooks(), plugins.GetConfigs()[0].GetDisabledHooks())
+
+	_, err = api.DisablePluginHooks(context.Background(), &v1.PluginHooks{
+		Name:  "plugin-name",
+		Hooks: []string{"HOOK_NAME_ON_NEW_LOGGER"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = api.EnablePluginHooks(context.Background(), &v1.PluginHooks{Name: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = api.ReloadPlugin(context.Background(), &v1.PluginName{Name: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = api.UnloadPlugin(context.Background(), &v1.PluginName{Name: "plugin-name"})
+	require.NoError(t, err)
+	assert.Empty(t, api.PluginRegistry.List())
+	_, err = api.UnloadPlugin(context.Background(), &v1.PluginName{Name: "plugin-name"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// The built-in plugins can't be unloaded, since they can't be loaded again.
+	api.PluginRegistry.Add(&plugin.Plugin{
+		ID:                          sdkPlugin.Identifier{Name: "builtin"},
+		GatewayDPluginServiceServer: &pluginV1.UnimplementedGatewayDPluginServiceServer{},
+	})
+	_, err = api.UnloadPlugin(context.Background(), &v1.PluginName{Name: "builtin"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = api.ReloadPlugin(context.Background(), &v1.PluginName{Name: "builtin"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	plugins, err = api.GetPlugins(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.True(t, plugins.GetConfigs()[0].GetBuiltin())
+}
+
+func TestPolicyOperations(t *testing.T) {
+	api := getAPIConfig()
+
+	policies, err := api.GetPolicies(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Len(t, policies.GetPolicies(), len(act.BuiltinPolicies()))
+	assert.Equal(t, config.DefaultPolicy, policies.GetDefaultPolicy())
+
+	metadata, err := structpb.NewStruct(map[string]interface{}{"level": "info"})
+	require.NoError(t, err)
+	policy := &v1.Policy{Name: "test", Policy: "Policy.level == 'info'", Metadata: metadata}
+	_, err = api.AddPolicy(context.Background(), policy)
+	require.NoError(t, err)
+	_, err = api.AddPolicy(context.Background(), policy)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = api.AddPolicy(context.Background(), &v1.Policy{Name: "invalid", Policy: "1 +"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = api.UpdatePolicy(context.Background(), &v1.Policy{Name: "unknown", Policy: "true"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = api.UpdatePolicy(context.Background(), &v1.Policy{Name: "terminate", Policy: "false"})
+	require.NoError(t, err)
+	assert.Equal(t, "false", api.PluginRegistry.ActRegistry.Policy("terminate").Policy)
+
+	signalMetadata, err := structpb.NewStruct(map[string]interface{}{"terminate": true})
+	require.NoError(t, err)
+	evaluation, err := api.EvaluatePolicy(context.Background(), &v1.EvaluatePolicyRequest{
+		Signals: []*v1.Signal{{Name: "terminate", Metadata: signalMetadata}},
+		Policy:  &v1.Policy{Name: "terminate", Policy: "Signal.terminate"},
+	})
+	require.NoError(t, err)
+	require.Len(t, evaluation.GetOutputs(), 1)
+	assert.Equal(t, "terminate", evaluation.GetOutputs()[0].GetMatchedPolicy())
+	assert.True(t, evaluation.GetOutputs()[0].GetVerdict().GetBoolValue())
+	assert.True(t, evaluation.GetOutputs()[0].GetTerminal())
+	// The draft policy isn't saved.
+	assert.Equal(t, "false", api.PluginRegistry.ActRegistry.Policy("terminate").Policy)
+
+	_, err = api.DeletePolicy(context.Background(), &v1.PolicyName{Name: "test"})
+	require.NoError(t, err)
+	_, err = api.DeletePolicy(context.Background(), &v1.PolicyName{Name: "test"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = api.DeletePolicy(context.Background(), &v1.PolicyName{Name: config.DefaultPolicy})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,306 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+
+	v1 "github.com/gatewayd-io/gatewayd/api/v1"
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// anonymous is the name of the callers when the authentication is disabled.
+const anonymous = "anonymous"
+
+var (
+	errMissingCredentials = errors.New("missing credentials")
+	errInvalidCredentials = errors.New("invalid credentials")
+)
+
+// methodRoles are the roles that are required to call the methods of the gRPC API.
+// The methods that aren't listed, including the ones that are added later,
+// require the admin role.
+var methodRoles = map[string]config.APIRole{
+	v1.GatewayDAdminAPIService_Version_FullMethodName:         config.RoleReadOnly,
+	v1.GatewayDAdminAPIService_GetPlugins_FullMethodName:      config.RoleReadOnly,
+	v1.GatewayDAdminAPIService_GetHookChains_FullMethodName:   config.RoleReadOnly,
+	v1.GatewayDAdminAPIService_GetPools_FullMethodName:        config.RoleReadOnly,
+	v1.GatewayDAdminAPIService_GetProxies_FullMethodName:      config.RoleReadOnly,
+	v1.GatewayDAdminAPIService_GetServers_FullMethodName:      config.RoleReadOnly,
+	v1.GatewayDAdminAPIService_ListConnections_FullMethodName: config.RoleReadOnly,
+	v1.GatewayDAdminAPIService_GetPolicies_FullMethodName:     config.RoleReadOnly,
+	v1.GatewayDAdminAPIService_StreamEvents_FullMethodName:    config.RoleReadOnly,
+	// The clients use the server reflection to discover the methods.
+	"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo":      config.RoleReadOnly,
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": config.RoleReadOnly,
+}
+
+// publicMethods are the methods of the gRPC API that can be called without
+// authentication, so that the load balancers can check the health of the GatewayD.
+var publicMethods = []string{
+	grpc_health_v1.Health_Check_FullMethodName,
+	grpc_health_v1.Health_Watch_FullMethodName,
+}
+
+// httpRoles are the roles that are required to call the endpoints of the HTTP API
+// that aren't served by the gRPC gateway. The gateway endpoints are authorized by
+// the gRPC API, which receives the credentials of the requests from the gateway.
+var httpRoles = map[string]config.APIRole{
+	"/version":   config.RoleReadOnly,
+	"/v1/events": config.RoleReadOnly,
+}
+
+// publicPaths are the endpoints of the HTTP API that can be called without authentication.
+var publicPaths = []string{"/healthz", "/readyz", "/swagger.json", "/swagger-ui/"}
+
+// Authenticator authenticates and authorizes the calls to the gRPC and HTTP APIs,
+// and logs the calls to the admin methods and the denied calls in the audit log.
+type Authenticator struct {
+	Auth   config.APIAuth
+	Logger zerolog.Logger
+}
+
+// Authenticate returns the credential of the token that the caller sent, either as a bearer
+// token in the Authorization header or in the X-API-Key header, depending on the method.
+func (a *Authenticator) Authenticate(authorization, apiKey string) (*config.APICredential, error) {
+	var token string
+	switch a.Auth.Method {
+	case "", config.AuthMethodNone:
+		return &config.APICredential{Name: anonymous, Role: "admin"}, nil
+	case config.AuthMethodBearer:
+		scheme, value, found := strings.Cut(authorization, " ")
+		if found && strings.EqualFold(scheme, "Bearer") {
+			token = strings.TrimSpace(value)
+		}
+	case config.AuthMethodAPIKey:
+		token = apiKey
+	}
+
+	if token == "" {
+		return nil, errMissingCredentials
+	}
+	for index := range a.Auth.Credentials {
+		credential := &a.Auth.Credentials[index]
+		if subtle.ConstantTimeCompare([]byte(credential.Token), []byte(token)) == 1 {
+			return credential, nil
+		}
+	}
+	return nil, errInvalidCredentials
+}
+
+// UnaryInterceptor authorizes the unary calls to the gRPC API.
+func (a *Authenticator) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	credential, err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		a.audit(ctx, info.FullMethod, credential, err)
+		return nil, err
+	}
+
+	resp, err := handler(ctx, req)
+	a.audit(ctx, info.FullMethod, credential, err)
+	return resp, err
+}
+
+// StreamInterceptor authorizes the streaming calls to the gRPC API.
+func (a *Authenticator) StreamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	credential, err := a.authorize(stream.Context(), info.FullMethod)
+	if err != nil {
+		a.audit(stream.Context(), info.FullMethod, credential, err)
+		return err
+	}
+
+	err = handler(srv, stream)
+	a.audit(stream.Context(), info.FullMethod, credential, err)
+	return err
+}
+
+// Middleware authenticates the requests to the HTTP API and authorizes
+// the requests to the endpoints that aren't served by the gRPC gateway.
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if isPublicPath(request.URL.Path) {
+			next.ServeHTTP(writer, request)
+			return
+		}
+
+		credential, err := a.Authenticate(
+			request.Header.Get("Authorization"), request.Header.Get(config.APIKeyHeader))
+		if err != nil {
+			a.auditHTTP(request, nil, err)
+			if a.Auth.Method == config.AuthMethodBearer {
+				writer.Header().Set("WWW-Authenticate", `Bearer realm="gatewayd"`)
+			}
+			http.Error(writer, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		if role, ok := httpRoles[request.URL.Path]; ok && credential.GetRole() < role {
+			err := errors.New("permission denied") //nolint:goerr113
+			a.auditHTTP(request, credential, err)
+			http.Error(writer, err.Error(), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(writer, request)
+	})
+}
+
+// authorize returns the credential of the caller of the gRPC method, and an error
+// if the caller isn't authenticated or its role isn't allowed to call the method.
+func (a *Authenticator) authorize(ctx context.Context, method string) (*config.APICredential, error) {
+	if slices.Contains(publicMethods, method) {
+		return nil, nil //nolint:nilnil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	credential, err := a.Authenticate(
+		firstValue(md, "authorization"), firstValue(md, strings.ToLower(config.APIKeyHeader)))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error()) //nolint:wrapcheck
+	}
+
+	if credential.GetRole() < requiredRole(method) {
+		return credential, status.Errorf( //nolint:wrapcheck
+			codes.PermissionDenied, "%q is not allowed to call %s", credential.Name, method)
+	}
+
+	return credential, nil
+}
+
+// audit logs the calls to the admin methods of the gRPC API and the denied calls.
+func (a *Authenticator) audit(
+	ctx context.Context, method string, credential *config.APICredential, err error,
+) {
+	code := status.Code(err)
+	denied := code == codes.Unauthenticated || code == codes.PermissionDenied
+	if !denied && (slices.Contains(publicMethods, method) || requiredRole(method) < config.RoleAdmin) {
+		return
+	}
+
+	event := a.Logger.Info()
+	if denied {
+		event = a.Logger.Warn()
+	}
+	event.Fields(
+		map[string]interface{}{
+			"method":  method,
+			"caller":  callerName(credential),
+			"address": callerAddress(ctx),
+			"code":    code.String(),
+		},
+	).Msg("Audit: API call")
+}
+
+// auditHTTP logs the denied requests to the HTTP API.
+// The X-Forwarded-For header isn't logged, because any client can send it.
+func (a *Authenticator) auditHTTP(request *http.Request, credential *config.APICredential, err error) {
+	a.Logger.Warn().Fields(
+		map[string]interface{}{
+			"method":  request.Method,
+			"path":    request.URL.Path,
+			"caller":  callerName(credential),
+			"address": request.RemoteAddr,
+			"error":   err.Error(),
+		},
+	).Msg("Audit: API call")
+}
+
+// requiredRole returns the role that is required to call the gRPC method.
+func requiredRole(method string) config.APIRole {
+	if role, ok := methodRoles[method]; ok {
+		return role
+	}
+	return config.RoleAdmin
+}
+
+// isPublicPath returns true if the endpoint of the HTTP API is public.
+func isPublicPath(path string) bool {
+	for _, public := range publicPaths {
+		if path == public || (strings.HasSuffix(public, "/") && strings.HasPrefix(path, public)) {
+			return true
+		}
+	}
+	return false
+}
+
+// forwardHeader forwards the API key header of the HTTP requests to the gRPC API, in addition
+// to the headers that the gateway forwards by default, e.g. the Authorization header.
+func forwardHeader(key string) (string, bool) {
+	if strings.EqualFold(key, config.APIKeyHeader) {
+		return strings.ToLower(key), true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// callerName returns the name of the credential of the caller, or an empty
+// string if the caller isn't authenticated.
+func callerName(credential *config.APICredential) string {
+	if credential == nil {
+		return ""
+	}
+	return credential.Name
+}
+
+// callerAddress returns the address of the caller of the gRPC method. The HTTP gateway
+// calls the methods from the loopback address and appends the address of the HTTP client
+// to the X-Forwarded-For header, so only the last address of the header is used, and only
+// if the caller is local. The other addresses of the header are sent by the client.
+func callerAddress(ctx context.Context) string {
+	caller, ok := peer.FromContext(ctx)
+	if !ok || caller.Addr == nil {
+		return ""
+	}
+	if !isLocal(caller.Addr) {
+		return caller.Addr.String()
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	forwardedFor := strings.Split(firstValue(md, "x-forwarded-for"), ",")
+	if client := strings.TrimSpace(forwardedFor[len(forwardedFor)-1]); client != "" {
+		return client
+	}
+	return caller.Addr.String()
+}
+
+// isLocal returns true if the address is a loopback address or a Unix socket.
+func isLocal(address net.Addr) bool {
+	if address.Network() == "unix" {
+		return true
+	}
+	host, _, err := net.SplitHostPort(address.String())
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// firstValue returns the first value of the metadata key, or an empty string.
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,284 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/gatewayd-io/gatewayd/api/v1"
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// lockedBuffer is a buffer that the servers can log to while the test reads it.
+type lockedBuffer struct {
+	mu     sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(data []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buffer.Write(data) //nolint:wrapcheck
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buffer.String()
+}
+
+var testCredentials = []config.APICredential{
+	{Name: "reader", Token: "reader-token", Role: "readonly"},
+	{Name: "operator", Token: "operator-token", Role: "admin"},
+}
+
+// createCertificate creates a self-signed certificate for localhost, which can be used
+// by the servers and the clients, and returns the paths of the certificate and the key.
+func createCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	privateKey, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(t.TempDir(), "api.crt")
+	keyFile := filepath.Join(t.TempDir(), "api.key")
+	require.NoError(t, os.WriteFile(
+		certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}), 0o600))
+	require.NoError(t, os.WriteFile(
+		keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKey}), 0o600))
+	return certFile, keyFile
+}
+
+func TestAuthenticate(t *testing.T) {
+	authenticator := &Authenticator{Auth: config.APIAuth{Method: config.AuthMethodNone}}
+	credential, err := authenticator.Authenticate("", "")
+	require.NoError(t, err)
+	assert.Equal(t, anonymous, credential.Name)
+	assert.Equal(t, config.RoleAdmin, credential.GetRole())
+
+	authenticator.Auth = config.APIAuth{Method: config.AuthMethodBearer, Credentials: testCredentials}
+	credential, err = authenticator.Authenticate("Bearer reader-token", "")
+	require.NoError(t, err)
+	assert.Equal(t, "reader", credential.Name)
+	assert.Equal(t, config.RoleReadOnly, credential.GetRole())
+	_, err = authenticator.Authenticate("Bearer unknown", "")
+	assert.ErrorIs(t, err, errInvalidCredentials)
+	_, err = authenticator.Authenticate("", "reader-token")
+	assert.ErrorIs(t, err, errMissingCredentials)
+
+	authenticator.Auth.Method = config.AuthMethodAPIKey
+	credential, err = authenticator.Authenticate("", "operator-token")
+	require.NoError(t, err)
+	assert.Equal(t, "operator", credential.Name)
+	assert.Equal(t, config.RoleAdmin, credential.GetRole())
+	_, err = authenticator.Authenticate("Bearer operator-token", "")
+	assert.ErrorIs(t, err, errMissingCredentials)
+}
+
+// TestGRPCAuth tests the authorization and the audit log of the gRPC API.
+func TestGRPCAuth(t *testing.T) {
+	var logs lockedBuffer
+	api := getAPIConfig()
+	api.Options.Logger = zerolog.New(&logs)
+	api.Options.GRPCAddress = "localhost:19190"
+	api.Options.Auth = config.APIAuth{Method: config.AuthMethodBearer, Credentials: testCredentials}
+	grpcServer := NewGRPCServer(GRPCServer{API: api, HealthChecker: &HealthChecker{Servers: api.Servers}})
+	require.NotNil(t, grpcServer)
+	go grpcServer.Start()
+	defer grpcServer.Shutdown(context.Background())
+
+	grpcClient, err := grpc.NewClient(
+		"localhost:19190", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer grpcClient.Close()
+	client := v1.NewGatewayDAdminAPIServiceClient(grpcClient)
+	withToken := func(token string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+	}
+
+	_, err = client.Version(context.Background(), &emptypb.Empty{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.Version(withToken("unknown"), &emptypb.Empty{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// The health checks are public.
+	health, err := grpc_health_v1.NewHealthClient(grpcClient).Check(
+		context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.NotNil(t, health)
+
+	version, err := client.Version(withToken("reader-token"), &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, config.Version, version.GetVersion())
+	_, err = client.GetGlobalConfig(withToken("reader-token"), &v1.Group{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.PausePool(withToken("reader-token"), &v1.PoolName{Name: config.Default})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.False(t, api.Proxies[config.Default].IsPaused())
+
+	poolStatus, err := client.PausePool(withToken("operator-token"), &v1.PoolName{Name: config.Default})
+	require.NoError(t, err)
+	assert.True(t, poolStatus.GetPaused())
+
+	// The admin calls and the denied calls are in the audit log, but not the others.
+	audit := strings.Split(strings.TrimSpace(logs.String()), "\n")
+	require.Len(t, audit, 5)
+	assert.Contains(t, audit[0], `"code":"Unauthenticated"`)
+	assert.Contains(t, audit[2], `"caller":"reader"`)
+	assert.Contains(t, audit[2], "GetGlobalConfig")
+	assert.Contains(t, audit[4], `"caller":"operator"`)
+	assert.Contains(t, audit[4], "PausePool")
+	assert.Contains(t, audit[4], `"code":"OK"`)
+	assert.NotContains(t, logs.String(), "reader-token")
+}
+
+// TestHTTPAuth tests the API key authentication of the HTTP API over mTLS.
+// TestCallerAddress tests that the audit log only trusts the address that the HTTP gateway
+// appends to the X-Forwarded-For header, and only if the call comes from the gateway.
+func TestCallerAddress(t *testing.T) {
+	forwarded := func(address net.Addr, forwardedFor string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: address})
+		return metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", forwardedFor))
+	}
+	remote := &net.TCPAddr{IP: net.ParseIP("192.0.2.10"), Port: 40000}
+	loopback := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 40000}
+
+	// A direct client can't forge its address.
+	assert.Equal(t, "192.0.2.10:40000", callerAddress(forwarded(remote, "10.0.0.1")))
+	// The gateway appends the address of the HTTP client after the forged ones.
+	assert.Equal(t, "198.51.100.7", callerAddress(forwarded(loopback, "10.0.0.1, 198.51.100.7")))
+	assert.Equal(t, "127.0.0.1:40000",
+		callerAddress(peer.NewContext(context.Background(), &peer.Peer{Addr: loopback})))
+	assert.Empty(t, callerAddress(context.Background()))
+}
+
+func TestHTTPAuth(t *testing.T) {
+	certFile, keyFile := createCertificate(t)
+	api := getAPIConfig()
+	api.Options.GRPCAddress = "localhost:19191"
+	api.Options.HTTPAddress = "localhost:18181"
+	api.Options.EnableTLS = true
+	api.Options.CertFile = certFile
+	api.Options.KeyFile = keyFile
+	api.Options.ClientCAFile = certFile
+	api.Options.Auth = config.APIAuth{Method: config.AuthMethodAPIKey, Credentials: testCredentials}
+
+	grpcServer := NewGRPCServer(GRPCServer{API: api, HealthChecker: &HealthChecker{Servers: api.Servers}})
+	require.NotNil(t, grpcServer)
+	go grpcServer.Start()
+	defer grpcServer.Shutdown(context.Background())
+	httpServer := NewHTTPServer(api.Options)
+	require.NotNil(t, httpServer)
+	go httpServer.Start()
+	defer httpServer.Shutdown(context.Background())
+
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	require.NoError(t, err)
+	pem, err := os.ReadFile(certFile)
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(pem))
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		RootCAs:      roots,
+		Certificates: []tls.Certificate{certificate},
+	}}}
+
+	call := func(method, path, apiKey string) int {
+		t.Helper()
+		request, err := http.NewRequestWithContext(
+			context.Background(), method, "https://localhost:18181"+path,
+			strings.NewReader(`{"name": "default"}`))
+		require.NoError(t, err)
+		if apiKey != "" {
+			request.Header.Set(config.APIKeyHeader, apiKey)
+		}
+		response, err := client.Do(request)
+		require.NoError(t, err)
+		defer response.Body.Close()
+		return response.StatusCode
+	}
+
+	require.Eventually(t, func() bool {
+		request, err := http.NewRequestWithContext(
+			context.Background(), http.MethodGet, "https://localhost:18181/healthz", nil)
+		require.NoError(t, err)
+		response, err := client.Do(request)
+		if err != nil {
+			return false
+		}
+		response.Body.Close()
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, http.StatusUnauthorized, call(http.MethodGet, "/version", ""))
+	assert.Equal(t, http.StatusOK, call(http.MethodGet, "/version", "reader-token"))
+	assert.Equal(t, http.StatusUnauthorized,
+		call(http.MethodGet, "/v1/GatewayDPluginService/Version", "unknown"))
+	assert.Equal(t, http.StatusOK,
+		call(http.MethodGet, "/v1/GatewayDPluginService/Version", "reader-token"))
+	assert.Equal(t, http.StatusForbidden,
+		call(http.MethodPost, "/v1/GatewayDPluginService/ResumePool", "reader-token"))
+	assert.Equal(t, http.StatusOK,
+		call(http.MethodPost, "/v1/GatewayDPluginService/ResumePool", "operator-token"))
+
+	// The clients without a certificate are rejected. A new client is used, since the
+	// connections of the other client may still be reused while they are being closed.
+	client = &http.Client{Transport: &http.Transport{
+		DisableKeepAlives: true,
+		TLSClientConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    roots,
+		},
+	}}
+	request, err := http.NewRequestWithContext(
+		context.Background(), http.MethodGet, "https://localhost:18181/healthz", nil)
+	require.NoError(t, err)
+	response, err := client.Do(request)
+	if err == nil {
+		response.Body.Close()
+	}
+	assert.Error(t, err)
+}
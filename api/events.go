@@ -0,0 +1,147 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	v1 "github.com/gatewayd-io/gatewayd/api/v1"
+	"github.com/gatewayd-io/gatewayd/events"
+	"github.com/gatewayd-io/gatewayd/metrics"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StreamEvents streams the events of the given types, or all the events, until the
+// client cancels the call or the event bus is closed.
+func (a *API) StreamEvents(
+	req *v1.StreamEventsRequest, stream v1.GatewayDAdminAPIService_StreamEventsServer,
+) error {
+	path := "/v1/GatewayDPluginService/StreamEvents"
+
+	types, err := parseEventTypes(req.GetTypes())
+	if err != nil {
+		metrics.APIRequestsErrors.WithLabelValues("GET", path, codes.InvalidArgument.String()).Inc()
+		return status.Error(codes.InvalidArgument, err.Error()) //nolint:wrapcheck
+	}
+
+	if a.Options == nil || a.Options.EventBus == nil {
+		metrics.APIRequestsErrors.WithLabelValues("GET", path, codes.Unavailable.String()).Inc()
+		return status.Error(codes.Unavailable, "the event stream is disabled") //nolint:wrapcheck
+	}
+
+	metrics.APIRequests.WithLabelValues("GET", path).Inc()
+
+	eventsCh, unsubscribe := a.Options.EventBus.Subscribe(types...)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-eventsCh:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(eventToProto(event)); err != nil {
+				return err //nolint:wrapcheck
+			}
+		}
+	}
+}
+
+// serveEvents streams the events as Server-Sent Events. The types of the events
+// can be given as a comma-separated list in the types query parameter.
+func serveEvents(options *Options) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		types, err := parseEventTypes(
+			strings.FieldsFunc(request.URL.Query().Get("types"), func(r rune) bool { return r == ',' }))
+		if err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		flusher, ok := writer.(http.Flusher)
+		if !ok || options.EventBus == nil {
+			http.Error(writer, "the event stream is disabled", http.StatusServiceUnavailable)
+			return
+		}
+
+		eventsCh, unsubscribe := options.EventBus.Subscribe(types...)
+		defer unsubscribe()
+
+		writer.Header().Set("Content-Type", "text/event-stream")
+		writer.Header().Set("Cache-Control", "no-cache")
+		writer.Header().Set("Connection", "keep-alive")
+		writer.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		for {
+			select {
+			case <-request.Context().Done():
+				return
+			case event, ok := <-eventsCh:
+				if !ok {
+					return
+				}
+
+				data, err := protojson.Marshal(eventToProto(event))
+				if err != nil {
+					options.Logger.Err(err).Msg("failed to marshal event")
+					continue
+				}
+				if _, err := fmt.Fprintf(
+					writer, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data,
+				); err != nil {
+					return
+				}
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+// publish publishes an event of the API to the event bus, if it is set.
+func (a *API) publish(eventType events.Type, data map[string]interface{}) {
+	if a.Options != nil {
+		a.Options.EventBus.Publish(eventType, "api", data)
+	}
+}
+
+// parseEventTypes returns the event types with the given names.
+func parseEventTypes(names []string) ([]events.Type, error) {
+	types := make([]events.Type, 0, len(names))
+	for _, name := range names {
+		eventType := events.Type(strings.TrimSpace(name))
+		if !slices.Contains(events.Types, eventType) {
+			return nil, fmt.Errorf("unknown event type %q", name) //nolint:goerr113
+		}
+		types = append(types, eventType)
+	}
+	return types, nil
+}
+
+// eventToProto converts an event to its API representation.
+func eventToProto(event events.Event) *v1.Event {
+	data, err := structpb.NewStruct(event.Data)
+	if err != nil {
+		// Some values, e.g. the verdicts of the policies, can only be converted through JSON.
+		data = &structpb.Struct{}
+		if raw, err := json.Marshal(event.Data); err == nil {
+			_ = protojson.Unmarshal(raw, data)
+		}
+	}
+
+	return &v1.Event{
+		Id:     event.ID,
+		Type:   string(event.Type),
+		Time:   timestamppb.New(event.Time),
+		Source: event.Source,
+		Data:   data,
+	}
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/gatewayd-io/gatewayd/api/v1"
+	"github.com/gatewayd-io/gatewayd/events"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// TestStreamEvents tests the event stream of the gRPC API.
+func TestStreamEvents(t *testing.T) {
+	api := getAPIConfig()
+	api.Options.GRPCAddress = "localhost:19192"
+	api.Options.EventBus = events.NewBus(events.Bus{Logger: zerolog.Nop()})
+	grpcServer := NewGRPCServer(GRPCServer{API: api, HealthChecker: &HealthChecker{Servers: api.Servers}})
+	require.NotNil(t, grpcServer)
+	go grpcServer.Start()
+	defer grpcServer.Shutdown(context.Background())
+
+	grpcClient, err := grpc.NewClient(
+		"localhost:19192", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer grpcClient.Close()
+	client := v1.NewGatewayDAdminAPIServiceClient(grpcClient)
+
+	stream, err := client.StreamEvents(
+		context.Background(), &v1.StreamEventsRequest{Types: []string{"unknown"}})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	stream, err = client.StreamEvents(
+		context.Background(), &v1.StreamEventsRequest{Types: []string{string(events.PluginCrashed)}})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return api.Options.EventBus.Subscribers() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	api.Options.EventBus.Publish(events.ConnectionOpened, "server", nil)
+	api.Options.EventBus.Publish(events.PluginCrashed, "plugin", map[string]interface{}{
+		"name": "plugin-name",
+	})
+	event, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), event.GetId())
+	assert.Equal(t, string(events.PluginCrashed), event.GetType())
+	assert.Equal(t, "plugin", event.GetSource())
+	assert.Equal(t, "plugin-name", event.GetData().GetFields()["name"].GetStringValue())
+
+	// Closing the bus ends the stream, so that the server can shut down.
+	api.Options.EventBus.Close()
+	_, err = stream.Recv()
+	assert.Error(t, err)
+}
+
+// TestServeEvents tests the Server-Sent Events endpoint of the HTTP API.
+func TestServeEvents(t *testing.T) {
+	options := &Options{
+		Logger:   zerolog.Nop(),
+		EventBus: events.NewBus(events.Bus{Logger: zerolog.Nop()}),
+	}
+	server := httptest.NewServer(serveEvents(options))
+	defer server.Close()
+
+	response, err := http.Get(server.URL + "?types=unknown") //nolint:noctx
+	require.NoError(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	response, err = http.Get( //nolint:noctx
+		server.URL + "?types=connection.opened,config.reloaded")
+	require.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+
+	options.EventBus.Publish(events.PolicyMatched, "act", nil)
+	options.EventBus.Publish(events.ConfigReloaded, "api", map[string]interface{}{
+		"globalConfigFile": "gatewayd.yaml",
+	})
+	options.EventBus.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(response.Body)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	require.Len(t, lines, 4)
+	assert.Equal(t, "id: 2", lines[0])
+	assert.Equal(t, "event: config.reloaded", lines[1])
+	data, found := strings.CutPrefix(lines[2], "data: ")
+	require.True(t, found)
+	var event v1.Event
+	require.NoError(t, protojson.Unmarshal([]byte(data), &event))
+	assert.Equal(t, string(events.ConfigReloaded), event.GetType())
+	assert.Equal(t, "gatewayd.yaml", event.GetData().GetFields()["globalConfigFile"].GetStringValue())
+	assert.Empty(t, lines[3])
+}
@@ -6,6 +6,7 @@ import (
 
 	v1 "github.com/gatewayd-io/gatewayd/api/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
@@ -45,13 +46,28 @@ func (s *GRPCServer) Shutdown(_ context.Context) {
 
 // createGRPCAPI creates a new gRPC API server and listener.
 func createGRPCAPI(api *API, healthchecker *HealthChecker) (*grpc.Server, net.Listener) {
+	tlsConfig, err := serverTLSConfig(api.Options)
+	if err != nil {
+		api.Options.Logger.Err(err).Msg("failed to configure TLS for the gRPC API")
+		return nil, nil
+	}
+
 	listener, err := net.Listen(api.Options.GRPCNetwork, api.Options.GRPCAddress)
 	if err != nil {
 		api.Options.Logger.Err(err).Msg("failed to start gRPC API")
 		return nil, nil
 	}
 
-	grpcServer := grpc.NewServer()
+	authenticator := &Authenticator{Auth: api.Options.Auth, Logger: api.Options.Logger}
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(authenticator.UnaryInterceptor),
+		grpc.ChainStreamInterceptor(authenticator.StreamInterceptor),
+	}
+	if tlsConfig != nil {
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	grpcServer := grpc.NewServer(serverOptions...)
 	reflection.Register(grpcServer)
 	v1.RegisterGatewayDAdminAPIServiceServer(grpcServer, api)
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthchecker)
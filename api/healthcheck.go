@@ -2,37 +2,148 @@ package api
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/gatewayd-io/gatewayd/events"
 	"github.com/gatewayd-io/gatewayd/network"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 )
 
+// The health of each server and of its proxy is reported under these prefixes followed
+// by the name of the server, e.g. server/default. The empty service name is the liveness
+// of the GatewayD, which is healthy if all the servers are running.
+const (
+	ServerServicePrefix = "server/"
+	ProxyServicePrefix  = "proxy/"
+)
+
+// readinessWatchInterval is how often the readiness is checked again for its watchers,
+// because the database servers and the plugins don't publish events when they fail.
+const readinessWatchInterval = time.Second
+
+// watchedEvents are the events that can change the status of a service.
+var watchedEvents = []events.Type{
+	events.ServerStarted,
+	events.ServerStopped,
+	events.ProxyPaused,
+	events.ProxyResumed,
+	events.ConnectionOpened,
+	events.ConnectionClosed,
+	events.PoolExhausted,
+	events.BackendReconnectFailed,
+}
+
 type HealthChecker struct {
 	grpc_health_v1.UnimplementedHealthServer
 
 	Servers map[string]*network.Server
+	// Readiness checks the readiness of the GatewayD for the readiness service.
+	Readiness *ReadinessChecker
+	// EventBus notifies the watchers of the changes of the servers and the proxies.
+	EventBus *events.Bus
 }
 
 func (h *HealthChecker) Check(
-	context.Context, *grpc_health_v1.HealthCheckRequest,
+	_ context.Context, req *grpc_health_v1.HealthCheckRequest,
 ) (*grpc_health_v1.HealthCheckResponse, error) {
-	// Check if all servers are running
-	if liveness(h.Servers) {
-		return &grpc_health_v1.HealthCheckResponse{
-			Status: grpc_health_v1.HealthCheckResponse_SERVING,
-		}, nil
+	servingStatus := h.status(req.GetService())
+	if servingStatus == grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN {
+		return nil, status.Errorf( //nolint:wrapcheck
+			codes.NotFound, "unknown service %q", req.GetService())
 	}
 
-	return &grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING,
-	}, nil
+	return &grpc_health_v1.HealthCheckResponse{Status: servingStatus}, nil
 }
 
+// Watch sends the status of the service and then every change of its status, until the
+// client cancels the call or the GatewayD shuts down. The status is checked again whenever
+// a server or a proxy publishes an event, instead of polling it. The readiness service is
+// also checked every readinessWatchInterval, which reuses the recent checks of the database
+// servers.
 func (h *HealthChecker) Watch(
-	*grpc_health_v1.HealthCheckRequest,
-	grpc_health_v1.Health_WatchServer,
+	req *grpc_health_v1.HealthCheckRequest,
+	stream grpc_health_v1.Health_WatchServer,
 ) error {
-	return status.Error(codes.Unimplemented, "not implemented") //nolint:wrapcheck
+	// Subscribe before the first check, so that no change is missed.
+	eventsCh, unsubscribe := h.EventBus.Subscribe(watchedEvents...)
+	defer unsubscribe()
+
+	var ticks <-chan time.Time
+	if req.GetService() == ReadinessService && h.Readiness != nil {
+		ticker := time.NewTicker(readinessWatchInterval)
+		defer ticker.Stop()
+		ticks = ticker.C
+	}
+
+	lastStatus := grpc_health_v1.HealthCheckResponse_ServingStatus(-1)
+	send := func(servingStatus grpc_health_v1.HealthCheckResponse_ServingStatus) error {
+		if servingStatus == lastStatus {
+			return nil
+		}
+		lastStatus = servingStatus
+		return stream.Send(&grpc_health_v1.HealthCheckResponse{Status: servingStatus})
+	}
+
+	if err := send(h.status(req.GetService())); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticks:
+			if err := send(h.status(req.GetService())); err != nil {
+				return err //nolint:wrapcheck
+			}
+		case _, ok := <-eventsCh:
+			if !ok {
+				// The event bus is closed when the GatewayD shuts down.
+				if lastStatus == grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN {
+					return nil
+				}
+				return send(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+			}
+			if err := send(h.status(req.GetService())); err != nil {
+				return err //nolint:wrapcheck
+			}
+		}
+	}
+}
+
+// status returns the status of the service, which is unknown if there is no such service.
+func (h *HealthChecker) status(service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	healthy := false
+	switch {
+	case service == "":
+		// Check if all servers are running
+		healthy = liveness(h.Servers)
+	case strings.HasPrefix(service, ServerServicePrefix):
+		server, ok := h.Servers[strings.TrimPrefix(service, ServerServicePrefix)]
+		if !ok {
+			return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+		}
+		healthy = server.IsRunning()
+	case strings.HasPrefix(service, ProxyServicePrefix):
+		server, ok := h.Servers[strings.TrimPrefix(service, ProxyServicePrefix)]
+		if !ok || server.Proxy == nil {
+			return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+		}
+		healthy = !server.Proxy.IsPaused() && !server.Proxy.IsExhausted()
+	case service == ReadinessService && h.Readiness != nil:
+		healthy = h.Readiness.Check().Status == Serving
+	case service == ReadinessService:
+		// Only the liveness is checked without the readiness checker.
+		healthy = liveness(h.Servers)
+	default:
+		return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+	}
+
+	if healthy {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
 }
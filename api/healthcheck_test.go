@@ -3,16 +3,21 @@ package api
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/gatewayd-io/gatewayd/act"
 	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/gatewayd-io/gatewayd/events"
 	"github.com/gatewayd-io/gatewayd/network"
 	"github.com/gatewayd-io/gatewayd/plugin"
 	"github.com/gatewayd-io/gatewayd/pool"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 )
 
 func Test_Healthchecker(t *testing.T) {
@@ -88,7 +93,73 @@ func Test_Healthchecker(t *testing.T) {
 	assert.NotNil(t, hcr)
 	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, hcr.GetStatus())
 
-	err = healthchecker.Watch(&grpc_health_v1.HealthCheckRequest{}, nil)
-	assert.Error(t, err)
-	assert.Equal(t, "rpc error: code = Unimplemented desc = not implemented", err.Error())
+	_, err = healthchecker.Check(
+		context.TODO(), &grpc_health_v1.HealthCheckRequest{Service: "server/unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+type healthWatchServer struct {
+	grpc.ServerStream
+
+	ctx      context.Context //nolint:containedctx
+	statuses chan grpc_health_v1.HealthCheckResponse_ServingStatus
+}
+
+func (s *healthWatchServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *healthWatchServer) Send(resp *grpc_health_v1.HealthCheckResponse) error {
+	s.statuses <- resp.GetStatus()
+	return nil
+}
+
+// Test_Healthchecker_Watch tests that the status changes of a proxy are pushed to the watchers.
+func Test_Healthchecker_Watch(t *testing.T) {
+	bus := events.NewBus(events.Bus{Logger: zerolog.Nop()})
+	api := getAPIConfig()
+	proxy := api.Proxies[config.Default]
+	proxy.EventBus = bus
+	// The pool is exhausted if it has no available connections.
+	require.Nil(t, proxy.AvailableConnections.Put("client", "placeholder"))
+
+	healthchecker := &HealthChecker{Servers: api.Servers, EventBus: bus}
+
+	watch := func(service string) (*healthWatchServer, chan error) {
+		stream := &healthWatchServer{
+			ctx:      context.Background(),
+			statuses: make(chan grpc_health_v1.HealthCheckResponse_ServingStatus, 10),
+		}
+		done := make(chan error, 1)
+		go func() {
+			done <- healthchecker.Watch(&grpc_health_v1.HealthCheckRequest{Service: service}, stream)
+		}()
+		return stream, done
+	}
+	next := func(stream *healthWatchServer) grpc_health_v1.HealthCheckResponse_ServingStatus {
+		select {
+		case servingStatus := <-stream.statuses:
+			return servingStatus
+		case <-time.After(time.Second):
+			t.Fatal("no status was sent")
+			return grpc_health_v1.HealthCheckResponse_UNKNOWN
+		}
+	}
+
+	proxyStream, proxyDone := watch(ProxyServicePrefix + config.Default)
+	unknownStream, unknownDone := watch(ServerServicePrefix + "unknown")
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, next(proxyStream))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN, next(unknownStream))
+
+	proxy.Pause()
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, next(proxyStream))
+	proxy.Resume()
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, next(proxyStream))
+
+	// The watchers are notified when the GatewayD shuts down.
+	bus.Close()
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, next(proxyStream))
+	require.NoError(t, <-proxyDone)
+	require.NoError(t, <-unknownDone)
+	assert.Empty(t, unknownStream.statuses)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -31,6 +32,11 @@ type HTTPServer struct {
 // NewHTTPServer creates a new HTTP server.
 func NewHTTPServer(options *Options) *HTTPServer {
 	httpServer := createHTTPAPI(options)
+	if httpServer == nil {
+		options.Logger.Error().Msg("Failed to create HTTP API server")
+		return nil
+	}
+
 	return &HTTPServer{
 		httpServer: httpServer,
 		options:    options,
@@ -50,11 +56,22 @@ func (s *HTTPServer) Shutdown(ctx context.Context) {
 
 // CreateHTTPAPI creates a new HTTP API.
 func createHTTPAPI(options *Options) *http.Server {
-	// Register gRPC server endpoint
-	// TODO: Make this configurable with TLS and Auth.
-	rmux := runtime.NewServeMux()
+	tlsConfig, err := serverTLSConfig(options)
+	if err != nil {
+		options.Logger.Err(err).Msg("failed to configure TLS for the HTTP API")
+		return nil
+	}
+
+	// Register gRPC server endpoint. The credentials of the requests are forwarded
+	// to the gRPC API, which authorizes the calls.
+	rmux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(forwardHeader))
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
-	err := v1.RegisterGatewayDAdminAPIServiceHandlerFromEndpoint(
+	if tlsConfig != nil {
+		opts = []grpc.DialOption{
+			grpc.WithTransportCredentials(credentials.NewTLS(gatewayTLSConfig(tlsConfig))),
+		}
+	}
+	err = v1.RegisterGatewayDAdminAPIServiceHandlerFromEndpoint(
 		context.Background(), rmux, options.GRPCAddress, opts)
 	if err != nil {
 		options.Logger.Err(err).Msg("failed to start HTTP API")
@@ -66,19 +83,23 @@ func createHTTPAPI(options *Options) *http.Server {
 		if liveness(options.Servers) {
 			writer.Header().Set("Content-Type", "application/json")
 			writer.WriteHeader(http.StatusOK)
-			if err := json.NewEncoder(writer).Encode(Healthz{Status: "SERVING"}); err != nil {
+			if err := json.NewEncoder(writer).Encode(Healthz{Status: Serving}); err != nil {
 				options.Logger.Err(err).Msg("failed to serve healthcheck")
 				writer.WriteHeader(http.StatusInternalServerError)
 			}
 		} else {
 			writer.Header().Set("Content-Type", "application/json")
 			writer.WriteHeader(http.StatusServiceUnavailable)
-			if err := json.NewEncoder(writer).Encode(Healthz{Status: "NOT_SERVING"}); err != nil {
+			if err := json.NewEncoder(writer).Encode(Healthz{Status: NotServing}); err != nil {
 				options.Logger.Err(err).Msg("failed to serve healthcheck")
 			}
 		}
 	})
 
+	mux.HandleFunc("/readyz", serveReadiness(options))
+
+	mux.HandleFunc("/v1/events", serveEvents(options))
+
 	mux.HandleFunc("/version", func(writer http.ResponseWriter, _ *http.Request) {
 		writer.WriteHeader(http.StatusOK)
 		if _, err := writer.Write([]byte(config.Version)); err != nil {
@@ -107,8 +128,9 @@ func createHTTPAPI(options *Options) *http.Server {
 
 	server := &http.Server{
 		Addr:              options.HTTPAddress,
-		Handler:           mux,
+		Handler:           (&Authenticator{Auth: options.Auth, Logger: options.Logger}).Middleware(mux),
 		ReadHeaderTimeout: headerReadTimeout,
+		TLSConfig:         tlsConfig,
 	}
 
 	return server
@@ -117,7 +139,14 @@ func createHTTPAPI(options *Options) *http.Server {
 // start starts the HTTP API.
 func (s *HTTPServer) start(options *Options, server *http.Server) {
 	// Start HTTP server (and proxy calls to gRPC server endpoint)
-	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+	var err error
+	if server.TLSConfig != nil {
+		// The certificate is already in the TLS config.
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		err = server.ListenAndServe()
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		options.Logger.Err(err).Msg("failed to start HTTP API")
 	}
 }
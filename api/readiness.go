@@ -0,0 +1,151 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	sdkPlugin "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin"
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/gatewayd-io/gatewayd/network"
+	"github.com/gatewayd-io/gatewayd/plugin"
+)
+
+// ReadinessService is the service name of the gRPC health check that reports
+// the readiness of the GatewayD instead of its liveness.
+const ReadinessService = "readiness"
+
+const (
+	Serving    = "SERVING"
+	NotServing = "NOT_SERVING"
+)
+
+type ServerReadiness struct {
+	Ready   bool `json:"ready"`
+	Running bool `json:"running"`
+}
+
+type ProxyReadiness struct {
+	Ready bool `json:"ready"`
+	Busy  int  `json:"busy"`
+	Size  int  `json:"size"`
+	// Error is the reason why the proxy isn't ready.
+	Error string `json:"error,omitempty"`
+}
+
+type PluginReadiness struct {
+	Ready    bool   `json:"ready"`
+	Required bool   `json:"required"`
+	Loaded   bool   `json:"loaded"`
+	Version  string `json:"version,omitempty"`
+}
+
+// Readiness is the result of the readiness check, with the readiness of each server,
+// of its proxy and of each plugin.
+type Readiness struct {
+	Status  string                     `json:"status"`
+	Servers map[string]ServerReadiness `json:"servers"`
+	Proxies map[string]ProxyReadiness  `json:"proxies"`
+	Plugins map[string]PluginReadiness `json:"plugins"`
+}
+
+// ReadinessChecker checks if the GatewayD can serve the clients, which is stricter than
+// the liveness check: the servers must be running, the pools must not be exhausted,
+// the database servers must accept connections and the required plugins must be loaded.
+type ReadinessChecker struct {
+	Servers         map[string]*network.Server
+	PluginRegistry  *plugin.Registry
+	RequiredPlugins []string
+	// PoolThreshold is the ratio of the busy connections to the size of a pool,
+	// from which the pool is considered to be exhausted.
+	PoolThreshold float64
+	DialTimeout   time.Duration
+}
+
+// Check runs the readiness check.
+func (r *ReadinessChecker) Check() Readiness {
+	threshold := r.PoolThreshold
+	if threshold <= 0 {
+		threshold = config.DefaultReadinessPoolThreshold
+	}
+	dialTimeout := r.DialTimeout
+	if dialTimeout <= 0 {
+		dialTimeout = config.DefaultReadinessDialTimeout
+	}
+
+	readiness := Readiness{
+		Status:  Serving,
+		Servers: map[string]ServerReadiness{},
+		Proxies: map[string]ProxyReadiness{},
+		Plugins: map[string]PluginReadiness{},
+	}
+
+	for name, server := range r.Servers {
+		running := server.IsRunning()
+		readiness.Servers[name] = ServerReadiness{Ready: running, Running: running}
+		if !running {
+			readiness.Status = NotServing
+		}
+
+		if server.Proxy == nil {
+			continue
+		}
+
+		busy, size := server.Proxy.PoolUsage()
+		proxy := ProxyReadiness{Ready: true, Busy: busy, Size: size}
+		if size > 0 && float64(busy)/float64(size) >= threshold {
+			proxy.Ready = false
+			proxy.Error = "pool is exhausted"
+		} else if err := server.Proxy.DialBackend(dialTimeout); err != nil {
+			proxy.Ready = false
+			proxy.Error = err.Error()
+		}
+		readiness.Proxies[name] = proxy
+		if !proxy.Ready {
+			readiness.Status = NotServing
+		}
+	}
+
+	if r.PluginRegistry != nil {
+		r.PluginRegistry.ForEach(func(pluginID sdkPlugin.Identifier, _ *plugin.Plugin) {
+			readiness.Plugins[pluginID.Name] = PluginReadiness{
+				Ready:   true,
+				Loaded:  true,
+				Version: pluginID.Version,
+			}
+		})
+	}
+	for _, name := range r.RequiredPlugins {
+		plugIn, loaded := readiness.Plugins[name]
+		plugIn.Required = true
+		readiness.Plugins[name] = plugIn
+		if !loaded {
+			readiness.Status = NotServing
+		}
+	}
+
+	return readiness
+}
+
+// serveReadiness serves the result of the readiness check, with the status code 503
+// if the GatewayD isn't ready, so that no more clients are routed to it.
+func serveReadiness(options *Options) http.HandlerFunc {
+	return func(writer http.ResponseWriter, _ *http.Request) {
+		readiness := Readiness{Status: NotServing}
+		if options.Readiness != nil {
+			readiness = options.Readiness.Check()
+		} else if liveness(options.Servers) {
+			readiness.Status = Serving
+		}
+
+		writer.Header().Set("Content-Type", "application/json")
+		if readiness.Status == Serving {
+			writer.WriteHeader(http.StatusOK)
+		} else {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		}
+		if err := json.NewEncoder(writer).Encode(readiness); err != nil {
+			options.Logger.Err(err).Msg("failed to serve readiness check")
+		}
+	}
+}
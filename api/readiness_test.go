@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// TestReadiness tests that the readiness check fails when a database server doesn't answer
+// or a required plugin isn't loaded, and that the HTTP and gRPC health checks report it.
+func TestReadiness(t *testing.T) {
+	backend, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer backend.Close()
+	go func() {
+		// The database server refuses the SSLRequest of the readiness check.
+		for {
+			conn, err := backend.Accept()
+			if err != nil {
+				return
+			}
+			request := make([]byte, 8)
+			if _, err := io.ReadFull(conn, request); err == nil {
+				_, _ = conn.Write([]byte{'N'})
+			}
+			conn.Close()
+		}
+	}()
+
+	api := getAPIConfig()
+	api.Servers[config.Default].Status = config.Running
+	api.Proxies[config.Default].ClientConfig = &config.Client{
+		Network: "tcp",
+		Address: backend.Addr().String(),
+	}
+
+	checker := &ReadinessChecker{
+		Servers:        api.Servers,
+		PluginRegistry: api.PluginRegistry,
+	}
+	api.Options.Readiness = checker
+	healthChecker := &HealthChecker{Servers: api.Servers, Readiness: checker}
+
+	readiness := checker.Check()
+	assert.Equal(t, Serving, readiness.Status)
+	assert.True(t, readiness.Servers[config.Default].Running)
+	assert.True(t, readiness.Proxies[config.Default].Ready)
+	assert.Equal(t, 0, readiness.Proxies[config.Default].Busy)
+	assert.Equal(t, config.DefaultPoolSize, readiness.Proxies[config.Default].Size)
+	assert.Empty(t, readiness.Plugins)
+
+	// A required plugin that isn't loaded.
+	checker.RequiredPlugins = []string{"gatewayd-plugin-cache"}
+	readiness = checker.Check()
+	assert.Equal(t, NotServing, readiness.Status)
+	assert.Equal(t,
+		PluginReadiness{Required: true}, readiness.Plugins["gatewayd-plugin-cache"])
+	checker.RequiredPlugins = nil
+
+	// The watchers of the readiness service are notified when it changes.
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+	stream := &healthWatchServer{
+		ctx:      watchCtx,
+		statuses: make(chan grpc_health_v1.HealthCheckResponse_ServingStatus, 10),
+	}
+	go func() {
+		_ = healthChecker.Watch(&grpc_health_v1.HealthCheckRequest{Service: ReadinessService}, stream)
+	}()
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, <-stream.statuses)
+
+	// The database server doesn't accept connections anymore, which is noticed
+	// once the result of the last check expires.
+	require.NoError(t, backend.Close())
+	require.Eventually(t, func() bool {
+		readiness = checker.Check()
+		return readiness.Status == NotServing
+	}, 5*time.Second, 100*time.Millisecond)
+	assert.True(t, readiness.Servers[config.Default].Ready)
+	assert.False(t, readiness.Proxies[config.Default].Ready)
+	assert.NotEmpty(t, readiness.Proxies[config.Default].Error)
+	select {
+	case servingStatus := <-stream.statuses:
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the readiness watcher wasn't notified")
+	}
+
+	recorder := httptest.NewRecorder()
+	serveReadiness(api.Options)(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	var body Readiness
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	assert.Equal(t, NotServing, body.Status)
+	assert.False(t, body.Proxies[config.Default].Ready)
+
+	// The readiness service fails, but the GatewayD is still alive.
+	resp, err := healthChecker.Check(
+		context.Background(), &grpc_health_v1.HealthCheckRequest{Service: ReadinessService})
+	require.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, resp.GetStatus())
+	resp, err = healthChecker.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.GetStatus())
+}
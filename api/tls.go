@@ -0,0 +1,73 @@
+package api
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// serverTLSConfig returns the TLS config of the gRPC and HTTP APIs, or nil if TLS is disabled.
+// If the client CA is set, the clients must present a certificate that is issued by it.
+func serverTLSConfig(options *Options) (*tls.Config, error) {
+	if !options.EnableTLS {
+		return nil, nil //nolint:nilnil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(options.CertFile, options.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the API certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{certificate},
+	}
+
+	if options.ClientCAFile != "" {
+		pem, err := os.ReadFile(options.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the client CA file: %w", err)
+		}
+
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificates found in the client CA file") //nolint:goerr113
+		}
+
+		// The HTTP gateway presents the certificate of the API to the gRPC API.
+		leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the API certificate: %w", err)
+		}
+		clientCAs.AddCert(leaf)
+
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
+
+// gatewayTLSConfig returns the TLS config that the HTTP gateway uses to call the gRPC API.
+// The gateway presents the certificate of the API as its client certificate, and it only
+// trusts the same certificate, since the address of the gRPC API may not be in it.
+func gatewayTLSConfig(serverConfig *tls.Config) *tls.Config {
+	certificate := serverConfig.Certificates[0]
+
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{certificate},
+		// The certificate is pinned by VerifyConnection instead.
+		InsecureSkipVerify: true, //nolint:gosec
+		VerifyConnection: func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 ||
+				!bytes.Equal(state.PeerCertificates[0].Raw, certificate.Certificate[0]) {
+				return errors.New("the gRPC API presented an unexpected certificate") //nolint:goerr113
+			}
+			return nil
+		},
+	}
+}
@@ -21,13 +21,31 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GatewayDAdminAPIService_Version_FullMethodName         = "/api.v1.GatewayDAdminAPIService/Version"
-	GatewayDAdminAPIService_GetGlobalConfig_FullMethodName = "/api.v1.GatewayDAdminAPIService/GetGlobalConfig"
-	GatewayDAdminAPIService_GetPluginConfig_FullMethodName = "/api.v1.GatewayDAdminAPIService/GetPluginConfig"
-	GatewayDAdminAPIService_GetPlugins_FullMethodName      = "/api.v1.GatewayDAdminAPIService/GetPlugins"
-	GatewayDAdminAPIService_GetPools_FullMethodName        = "/api.v1.GatewayDAdminAPIService/GetPools"
-	GatewayDAdminAPIService_GetProxies_FullMethodName      = "/api.v1.GatewayDAdminAPIService/GetProxies"
-	GatewayDAdminAPIService_GetServers_FullMethodName      = "/api.v1.GatewayDAdminAPIService/GetServers"
+	GatewayDAdminAPIService_Version_FullMethodName            = "/api.v1.GatewayDAdminAPIService/Version"
+	GatewayDAdminAPIService_GetGlobalConfig_FullMethodName    = "/api.v1.GatewayDAdminAPIService/GetGlobalConfig"
+	GatewayDAdminAPIService_GetPluginConfig_FullMethodName    = "/api.v1.GatewayDAdminAPIService/GetPluginConfig"
+	GatewayDAdminAPIService_GetPlugins_FullMethodName         = "/api.v1.GatewayDAdminAPIService/GetPlugins"
+	GatewayDAdminAPIService_GetHookChains_FullMethodName      = "/api.v1.GatewayDAdminAPIService/GetHookChains"
+	GatewayDAdminAPIService_GetPools_FullMethodName           = "/api.v1.GatewayDAdminAPIService/GetPools"
+	GatewayDAdminAPIService_GetProxies_FullMethodName         = "/api.v1.GatewayDAdminAPIService/GetProxies"
+	GatewayDAdminAPIService_GetServers_FullMethodName         = "/api.v1.GatewayDAdminAPIService/GetServers"
+	GatewayDAdminAPIService_ListConnections_FullMethodName    = "/api.v1.GatewayDAdminAPIService/ListConnections"
+	GatewayDAdminAPIService_KillConnection_FullMethodName     = "/api.v1.GatewayDAdminAPIService/KillConnection"
+	GatewayDAdminAPIService_PausePool_FullMethodName          = "/api.v1.GatewayDAdminAPIService/PausePool"
+	GatewayDAdminAPIService_ResumePool_FullMethodName         = "/api.v1.GatewayDAdminAPIService/ResumePool"
+	GatewayDAdminAPIService_ReconnectPool_FullMethodName      = "/api.v1.GatewayDAdminAPIService/ReconnectPool"
+	GatewayDAdminAPIService_ResizePool_FullMethodName         = "/api.v1.GatewayDAdminAPIService/ResizePool"
+	GatewayDAdminAPIService_LoadPlugin_FullMethodName         = "/api.v1.GatewayDAdminAPIService/LoadPlugin"
+	GatewayDAdminAPIService_UnloadPlugin_FullMethodName       = "/api.v1.GatewayDAdminAPIService/UnloadPlugin"
+	GatewayDAdminAPIService_ReloadPlugin_FullMethodName       = "/api.v1.GatewayDAdminAPIService/ReloadPlugin"
+	GatewayDAdminAPIService_EnablePluginHooks_FullMethodName  = "/api.v1.GatewayDAdminAPIService/EnablePluginHooks"
+	GatewayDAdminAPIService_DisablePluginHooks_FullMethodName = "/api.v1.GatewayDAdminAPIService/DisablePluginHooks"
+	GatewayDAdminAPIService_GetPolicies_FullMethodName        = "/api.v1.GatewayDAdminAPIService/GetPolicies"
+	GatewayDAdminAPIService_AddPolicy_FullMethodName          = "/api.v1.GatewayDAdminAPIService/AddPolicy"
+	GatewayDAdminAPIService_UpdatePolicy_FullMethodName       = "/api.v1.GatewayDAdminAPIService/UpdatePolicy"
+	GatewayDAdminAPIService_DeletePolicy_FullMethodName       = "/api.v1.GatewayDAdminAPIService/DeletePolicy"
+	GatewayDAdminAPIService_EvaluatePolicy_FullMethodName     = "/api.v1.GatewayDAdminAPIService/EvaluatePolicy"
+	GatewayDAdminAPIService_StreamEvents_FullMethodName       = "/api.v1.GatewayDAdminAPIService/StreamEvents"
 )
 
 // GatewayDAdminAPIServiceClient is the client API for GatewayDAdminAPIService service.
@@ -42,12 +60,49 @@ type GatewayDAdminAPIServiceClient interface {
 	GetPluginConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*structpb.Struct, error)
 	// GetPlugins returns the list of plugins installed on the GatewayD.
 	GetPlugins(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PluginConfigs, error)
+	// GetHookChains returns the hooks of each type in the order they are run.
+	GetHookChains(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HookChains, error)
 	// GetPools returns the list of pools configured on the GatewayD.
 	GetPools(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*structpb.Struct, error)
 	// GetProxies returns the list of proxies configured on the GatewayD.
 	GetProxies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*structpb.Struct, error)
 	// GetServers returns the list of servers configured on the GatewayD.
 	GetServers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*structpb.Struct, error)
+	// ListConnections returns the list of client connections proxied by the GatewayD.
+	ListConnections(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Connections, error)
+	// KillConnection terminates the given client connection.
+	KillConnection(ctx context.Context, in *ConnectionID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PausePool holds the new queries of the given pool at transaction boundaries.
+	PausePool(ctx context.Context, in *PoolName, opts ...grpc.CallOption) (*PoolStatus, error)
+	// ResumePool lets the held queries of the given pool continue.
+	ResumePool(ctx context.Context, in *PoolName, opts ...grpc.CallOption) (*PoolStatus, error)
+	// ReconnectPool recycles the server connections of the given pool gracefully.
+	ReconnectPool(ctx context.Context, in *PoolName, opts ...grpc.CallOption) (*PoolStatus, error)
+	// ResizePool changes the number of server connections of the given pool.
+	ResizePool(ctx context.Context, in *ResizePoolRequest, opts ...grpc.CallOption) (*PoolStatus, error)
+	// LoadPlugin loads a plugin from the plugins configuration file and registers its hooks.
+	LoadPlugin(ctx context.Context, in *PluginName, opts ...grpc.CallOption) (*PluginConfig, error)
+	// UnloadPlugin removes the hooks of a plugin and stops it once the running hook calls return.
+	UnloadPlugin(ctx context.Context, in *PluginName, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ReloadPlugin unloads a plugin and loads it again from the plugins configuration file.
+	ReloadPlugin(ctx context.Context, in *PluginName, opts ...grpc.CallOption) (*PluginConfig, error)
+	// EnablePluginHooks registers the given hooks of a plugin, or all of them if none are given.
+	EnablePluginHooks(ctx context.Context, in *PluginHooks, opts ...grpc.CallOption) (*PluginConfig, error)
+	// DisablePluginHooks removes the given hooks of a plugin, or all of them if none are given.
+	DisablePluginHooks(ctx context.Context, in *PluginHooks, opts ...grpc.CallOption) (*PluginConfig, error)
+	// GetPolicies returns the policies of the act registry.
+	GetPolicies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Policies, error)
+	// AddPolicy adds a policy to the act registry. The policies that are changed at runtime aren't saved to the configuration file.
+	AddPolicy(ctx context.Context, in *Policy, opts ...grpc.CallOption) (*Policy, error)
+	// UpdatePolicy replaces a policy of the act registry, including the built-in policies.
+	UpdatePolicy(ctx context.Context, in *Policy, opts ...grpc.CallOption) (*Policy, error)
+	// DeletePolicy removes a policy from the act registry. The default policy can't be removed.
+	DeletePolicy(ctx context.Context, in *PolicyName, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// EvaluatePolicy evaluates the policies for the given signals without running any action.
+	EvaluatePolicy(ctx context.Context, in *EvaluatePolicyRequest, opts ...grpc.CallOption) (*EvaluatePolicyResponse, error)
+	// StreamEvents streams the events of the GatewayD as they happen, e.g. the connections that are
+	// opened and closed. The events are also served as Server-Sent Events by the /v1/events endpoint.
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (GatewayDAdminAPIService_StreamEventsClient, error)
 }
 
 type gatewayDAdminAPIServiceClient struct {
@@ -94,6 +149,15 @@ func (c *gatewayDAdminAPIServiceClient) GetPlugins(ctx context.Context, in *empt
 	return out, nil
 }
 
+func (c *gatewayDAdminAPIServiceClient) GetHookChains(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HookChains, error) {
+	out := new(HookChains)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_GetHookChains_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayDAdminAPIServiceClient) GetPools(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*structpb.Struct, error) {
 	out := new(structpb.Struct)
 	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_GetPools_FullMethodName, in, out, opts...)
@@ -121,6 +185,182 @@ func (c *gatewayDAdminAPIServiceClient) GetServers(ctx context.Context, in *empt
 	return out, nil
 }
 
+func (c *gatewayDAdminAPIServiceClient) ListConnections(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Connections, error) {
+	out := new(Connections)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_ListConnections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) KillConnection(ctx context.Context, in *ConnectionID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_KillConnection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) PausePool(ctx context.Context, in *PoolName, opts ...grpc.CallOption) (*PoolStatus, error) {
+	out := new(PoolStatus)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_PausePool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) ResumePool(ctx context.Context, in *PoolName, opts ...grpc.CallOption) (*PoolStatus, error) {
+	out := new(PoolStatus)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_ResumePool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) ReconnectPool(ctx context.Context, in *PoolName, opts ...grpc.CallOption) (*PoolStatus, error) {
+	out := new(PoolStatus)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_ReconnectPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) ResizePool(ctx context.Context, in *ResizePoolRequest, opts ...grpc.CallOption) (*PoolStatus, error) {
+	out := new(PoolStatus)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_ResizePool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) LoadPlugin(ctx context.Context, in *PluginName, opts ...grpc.CallOption) (*PluginConfig, error) {
+	out := new(PluginConfig)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_LoadPlugin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) UnloadPlugin(ctx context.Context, in *PluginName, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_UnloadPlugin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) ReloadPlugin(ctx context.Context, in *PluginName, opts ...grpc.CallOption) (*PluginConfig, error) {
+	out := new(PluginConfig)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_ReloadPlugin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) EnablePluginHooks(ctx context.Context, in *PluginHooks, opts ...grpc.CallOption) (*PluginConfig, error) {
+	out := new(PluginConfig)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_EnablePluginHooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) DisablePluginHooks(ctx context.Context, in *PluginHooks, opts ...grpc.CallOption) (*PluginConfig, error) {
+	out := new(PluginConfig)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_DisablePluginHooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) GetPolicies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Policies, error) {
+	out := new(Policies)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_GetPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) AddPolicy(ctx context.Context, in *Policy, opts ...grpc.CallOption) (*Policy, error) {
+	out := new(Policy)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_AddPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) UpdatePolicy(ctx context.Context, in *Policy, opts ...grpc.CallOption) (*Policy, error) {
+	out := new(Policy)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_UpdatePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) DeletePolicy(ctx context.Context, in *PolicyName, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_DeletePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) EvaluatePolicy(ctx context.Context, in *EvaluatePolicyRequest, opts ...grpc.CallOption) (*EvaluatePolicyResponse, error) {
+	out := new(EvaluatePolicyResponse)
+	err := c.cc.Invoke(ctx, GatewayDAdminAPIService_EvaluatePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayDAdminAPIServiceClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (GatewayDAdminAPIService_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GatewayDAdminAPIService_ServiceDesc.Streams[0], GatewayDAdminAPIService_StreamEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayDAdminAPIServiceStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GatewayDAdminAPIService_StreamEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type gatewayDAdminAPIServiceStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayDAdminAPIServiceStreamEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // GatewayDAdminAPIServiceServer is the server API for GatewayDAdminAPIService service.
 // All implementations must embed UnimplementedGatewayDAdminAPIServiceServer
 // for forward compatibility
@@ -133,12 +373,49 @@ type GatewayDAdminAPIServiceServer interface {
 	GetPluginConfig(context.Context, *emptypb.Empty) (*structpb.Struct, error)
 	// GetPlugins returns the list of plugins installed on the GatewayD.
 	GetPlugins(context.Context, *emptypb.Empty) (*PluginConfigs, error)
+	// GetHookChains returns the hooks of each type in the order they are run.
+	GetHookChains(context.Context, *emptypb.Empty) (*HookChains, error)
 	// GetPools returns the list of pools configured on the GatewayD.
 	GetPools(context.Context, *emptypb.Empty) (*structpb.Struct, error)
 	// GetProxies returns the list of proxies configured on the GatewayD.
 	GetProxies(context.Context, *emptypb.Empty) (*structpb.Struct, error)
 	// GetServers returns the list of servers configured on the GatewayD.
 	GetServers(context.Context, *emptypb.Empty) (*structpb.Struct, error)
+	// ListConnections returns the list of client connections proxied by the GatewayD.
+	ListConnections(context.Context, *emptypb.Empty) (*Connections, error)
+	// KillConnection terminates the given client connection.
+	KillConnection(context.Context, *ConnectionID) (*emptypb.Empty, error)
+	// PausePool holds the new queries of the given pool at transaction boundaries.
+	PausePool(context.Context, *PoolName) (*PoolStatus, error)
+	// ResumePool lets the held queries of the given pool continue.
+	ResumePool(context.Context, *PoolName) (*PoolStatus, error)
+	// ReconnectPool recycles the server connections of the given pool gracefully.
+	ReconnectPool(context.Context, *PoolName) (*PoolStatus, error)
+	// ResizePool changes the number of server connections of the given pool.
+	ResizePool(context.Context, *ResizePoolRequest) (*PoolStatus, error)
+	// LoadPlugin loads a plugin from the plugins configuration file and registers its hooks.
+	LoadPlugin(context.Context, *PluginName) (*PluginConfig, error)
+	// UnloadPlugin removes the hooks of a plugin and stops it once the running hook calls return.
+	UnloadPlugin(context.Context, *PluginName) (*emptypb.Empty, error)
+	// ReloadPlugin unloads a plugin and loads it again from the plugins configuration file.
+	ReloadPlugin(context.Context, *PluginName) (*PluginConfig, error)
+	// EnablePluginHooks registers the given hooks of a plugin, or all of them if none are given.
+	EnablePluginHooks(context.Context, *PluginHooks) (*PluginConfig, error)
+	// DisablePluginHooks removes the given hooks of a plugin, or all of them if none are given.
+	DisablePluginHooks(context.Context, *PluginHooks) (*PluginConfig, error)
+	// GetPolicies returns the policies of the act registry.
+	GetPolicies(context.Context, *emptypb.Empty) (*Policies, error)
+	// AddPolicy adds a policy to the act registry. The policies that are changed at runtime aren't saved to the configuration file.
+	AddPolicy(context.Context, *Policy) (*Policy, error)
+	// UpdatePolicy replaces a policy of the act registry, including the built-in policies.
+	UpdatePolicy(context.Context, *Policy) (*Policy, error)
+	// DeletePolicy removes a policy from the act registry. The default policy can't be removed.
+	DeletePolicy(context.Context, *PolicyName) (*emptypb.Empty, error)
+	// EvaluatePolicy evaluates the policies for the given signals without running any action.
+	EvaluatePolicy(context.Context, *EvaluatePolicyRequest) (*EvaluatePolicyResponse, error)
+	// StreamEvents streams the events of the GatewayD as they happen, e.g. the connections that are
+	// opened and closed. The events are also served as Server-Sent Events by the /v1/events endpoint.
+	StreamEvents(*StreamEventsRequest, GatewayDAdminAPIService_StreamEventsServer) error
 	mustEmbedUnimplementedGatewayDAdminAPIServiceServer()
 }
 
@@ -158,6 +435,9 @@ func (UnimplementedGatewayDAdminAPIServiceServer) GetPluginConfig(context.Contex
 func (UnimplementedGatewayDAdminAPIServiceServer) GetPlugins(context.Context, *emptypb.Empty) (*PluginConfigs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlugins not implemented")
 }
+func (UnimplementedGatewayDAdminAPIServiceServer) GetHookChains(context.Context, *emptypb.Empty) (*HookChains, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHookChains not implemented")
+}
 func (UnimplementedGatewayDAdminAPIServiceServer) GetPools(context.Context, *emptypb.Empty) (*structpb.Struct, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPools not implemented")
 }
@@ -167,6 +447,57 @@ func (UnimplementedGatewayDAdminAPIServiceServer) GetProxies(context.Context, *e
 func (UnimplementedGatewayDAdminAPIServiceServer) GetServers(context.Context, *emptypb.Empty) (*structpb.Struct, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServers not implemented")
 }
+func (UnimplementedGatewayDAdminAPIServiceServer) ListConnections(context.Context, *emptypb.Empty) (*Connections, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnections not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) KillConnection(context.Context, *ConnectionID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillConnection not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) PausePool(context.Context, *PoolName) (*PoolStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausePool not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) ResumePool(context.Context, *PoolName) (*PoolStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumePool not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) ReconnectPool(context.Context, *PoolName) (*PoolStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconnectPool not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) ResizePool(context.Context, *ResizePoolRequest) (*PoolStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizePool not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) LoadPlugin(context.Context, *PluginName) (*PluginConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadPlugin not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) UnloadPlugin(context.Context, *PluginName) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnloadPlugin not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) ReloadPlugin(context.Context, *PluginName) (*PluginConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadPlugin not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) EnablePluginHooks(context.Context, *PluginHooks) (*PluginConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnablePluginHooks not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) DisablePluginHooks(context.Context, *PluginHooks) (*PluginConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisablePluginHooks not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) GetPolicies(context.Context, *emptypb.Empty) (*Policies, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPolicies not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) AddPolicy(context.Context, *Policy) (*Policy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPolicy not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) UpdatePolicy(context.Context, *Policy) (*Policy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePolicy not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) DeletePolicy(context.Context, *PolicyName) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) EvaluatePolicy(context.Context, *EvaluatePolicyRequest) (*EvaluatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluatePolicy not implemented")
+}
+func (UnimplementedGatewayDAdminAPIServiceServer) StreamEvents(*StreamEventsRequest, GatewayDAdminAPIService_StreamEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
 func (UnimplementedGatewayDAdminAPIServiceServer) mustEmbedUnimplementedGatewayDAdminAPIServiceServer() {
 }
 
@@ -253,6 +584,24 @@ func _GatewayDAdminAPIService_GetPlugins_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayDAdminAPIService_GetHookChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).GetHookChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_GetHookChains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).GetHookChains(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GatewayDAdminAPIService_GetPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -307,6 +656,315 @@ func _GatewayDAdminAPIService_GetServers_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayDAdminAPIService_ListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).ListConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_ListConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).ListConnections(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_KillConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).KillConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_KillConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).KillConnection(ctx, req.(*ConnectionID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_PausePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).PausePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_PausePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).PausePool(ctx, req.(*PoolName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_ResumePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).ResumePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_ResumePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).ResumePool(ctx, req.(*PoolName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_ReconnectPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).ReconnectPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_ReconnectPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).ReconnectPool(ctx, req.(*PoolName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_ResizePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).ResizePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_ResizePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).ResizePool(ctx, req.(*ResizePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_LoadPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).LoadPlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_LoadPlugin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).LoadPlugin(ctx, req.(*PluginName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_UnloadPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).UnloadPlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_UnloadPlugin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).UnloadPlugin(ctx, req.(*PluginName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_ReloadPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).ReloadPlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_ReloadPlugin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).ReloadPlugin(ctx, req.(*PluginName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_EnablePluginHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginHooks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).EnablePluginHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_EnablePluginHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).EnablePluginHooks(ctx, req.(*PluginHooks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_DisablePluginHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginHooks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).DisablePluginHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_DisablePluginHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).DisablePluginHooks(ctx, req.(*PluginHooks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_GetPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).GetPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_GetPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).GetPolicies(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_AddPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Policy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).AddPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_AddPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).AddPolicy(ctx, req.(*Policy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_UpdatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Policy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).UpdatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_UpdatePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).UpdatePolicy(ctx, req.(*Policy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).DeletePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_DeletePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).DeletePolicy(ctx, req.(*PolicyName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_EvaluatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayDAdminAPIServiceServer).EvaluatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayDAdminAPIService_EvaluatePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayDAdminAPIServiceServer).EvaluatePolicy(ctx, req.(*EvaluatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayDAdminAPIService_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayDAdminAPIServiceServer).StreamEvents(m, &gatewayDAdminAPIServiceStreamEventsServer{stream})
+}
+
+type GatewayDAdminAPIService_StreamEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type gatewayDAdminAPIServiceStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayDAdminAPIServiceStreamEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // GatewayDAdminAPIService_ServiceDesc is the grpc.ServiceDesc for GatewayDAdminAPIService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -330,6 +988,10 @@ var GatewayDAdminAPIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPlugins",
 			Handler:    _GatewayDAdminAPIService_GetPlugins_Handler,
 		},
+		{
+			MethodName: "GetHookChains",
+			Handler:    _GatewayDAdminAPIService_GetHookChains_Handler,
+		},
 		{
 			MethodName: "GetPools",
 			Handler:    _GatewayDAdminAPIService_GetPools_Handler,
@@ -342,7 +1004,77 @@ var GatewayDAdminAPIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetServers",
 			Handler:    _GatewayDAdminAPIService_GetServers_Handler,
 		},
+		{
+			MethodName: "ListConnections",
+			Handler:    _GatewayDAdminAPIService_ListConnections_Handler,
+		},
+		{
+			MethodName: "KillConnection",
+			Handler:    _GatewayDAdminAPIService_KillConnection_Handler,
+		},
+		{
+			MethodName: "PausePool",
+			Handler:    _GatewayDAdminAPIService_PausePool_Handler,
+		},
+		{
+			MethodName: "ResumePool",
+			Handler:    _GatewayDAdminAPIService_ResumePool_Handler,
+		},
+		{
+			MethodName: "ReconnectPool",
+			Handler:    _GatewayDAdminAPIService_ReconnectPool_Handler,
+		},
+		{
+			MethodName: "ResizePool",
+			Handler:    _GatewayDAdminAPIService_ResizePool_Handler,
+		},
+		{
+			MethodName: "LoadPlugin",
+			Handler:    _GatewayDAdminAPIService_LoadPlugin_Handler,
+		},
+		{
+			MethodName: "UnloadPlugin",
+			Handler:    _GatewayDAdminAPIService_UnloadPlugin_Handler,
+		},
+		{
+			MethodName: "ReloadPlugin",
+			Handler:    _GatewayDAdminAPIService_ReloadPlugin_Handler,
+		},
+		{
+			MethodName: "EnablePluginHooks",
+			Handler:    _GatewayDAdminAPIService_EnablePluginHooks_Handler,
+		},
+		{
+			MethodName: "DisablePluginHooks",
+			Handler:    _GatewayDAdminAPIService_DisablePluginHooks_Handler,
+		},
+		{
+			MethodName: "GetPolicies",
+			Handler:    _GatewayDAdminAPIService_GetPolicies_Handler,
+		},
+		{
+			MethodName: "AddPolicy",
+			Handler:    _GatewayDAdminAPIService_AddPolicy_Handler,
+		},
+		{
+			MethodName: "UpdatePolicy",
+			Handler:    _GatewayDAdminAPIService_UpdatePolicy_Handler,
+		},
+		{
+			MethodName: "DeletePolicy",
+			Handler:    _GatewayDAdminAPIService_DeletePolicy_Handler,
+		},
+		{
+			MethodName: "EvaluatePolicy",
+			Handler:    _GatewayDAdminAPIService_EvaluatePolicy_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _GatewayDAdminAPIService_StreamEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/api.proto",
 }
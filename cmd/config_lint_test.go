@@ -3,8 +3,10 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
+	gerr "github.com/gatewayd-io/gatewayd/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -33,3 +35,17 @@ func Test_configLintCmd(t *testing.T) {
 	err = os.Remove(globalTestConfigFile)
 	assert.Nil(t, err)
 }
+
+// Test_lintConfigSignaturePolicy tests that an unknown signature policy fails the linting,
+// instead of being replaced by the default policy.
+func Test_lintConfigSignaturePolicy(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "gatewayd.yaml")
+
+	require.NoError(t, os.WriteFile(configFile, []byte(
+		"pluginSignatures:\n  policy: required\n  trustedKeys: []\n"), FilePermissions))
+	require.Nil(t, lintConfig(Global, configFile))
+
+	require.NoError(t, os.WriteFile(configFile, []byte(
+		"pluginSignatures:\n  policy: requried\n  trustedKeys: []\n"), FilePermissions))
+	assert.ErrorIs(t, lintConfig(Global, configFile), gerr.ErrLintingFailed)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/gatewayd-io/gatewayd/config"
 	gerr "github.com/gatewayd-io/gatewayd/errors"
+	"github.com/gatewayd-io/gatewayd/plugin"
 	jsonSchemaGenerator "github.com/invopop/jsonschema"
 	"github.com/knadh/koanf"
 	koanfJson "github.com/knadh/koanf/parsers/json"
@@ -149,5 +150,26 @@ func lintConfig(fileType configFileType, configFile string) *gerr.GatewayDError
 		return gerr.ErrLintingFailed.Wrap(err)
 	}
 
+	// The hooks are ordered by the priorities of the plugins, so they must not conflict.
+	if fileType == Plugins {
+		if err := plugin.ValidatePriorities(
+			conf.Plugin.Plugins, conf.Plugin.ExpressionHooks); err != nil {
+			return gerr.ErrLintingFailed.Wrap(err)
+		}
+		if err := plugin.ValidateExpressionHooks(conf.Plugin.ExpressionHooks); err != nil {
+			return gerr.ErrLintingFailed.Wrap(err)
+		}
+	}
+
+	// The plugins must not be loaded with a policy or keys that are silently weakened.
+	if fileType == Global {
+		if _, err := conf.Global.PluginSignatures.GetPolicy(); err != nil {
+			return gerr.ErrLintingFailed.Wrap(err)
+		}
+		if _, err := plugin.ParseTrustedKeys(conf.Global.PluginSignatures.TrustedKeys); err != nil {
+			return gerr.ErrLintingFailed.Wrap(err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,312 @@
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	v1 "github.com/gatewayd-io/gatewayd/api/v1"
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	TableOutput = "table"
+	JSONOutput  = "json"
+	YAMLOutput  = "yaml"
+
+	// APITokenEnvVar is read if the token isn't given as a flag, so that it
+	// doesn't end up in the shell history.
+	APITokenEnvVar = "GATEWAYD_API_TOKEN"
+
+	DefaultCtlTimeout = 10 * time.Second
+)
+
+var (
+	ctlNetwork    string
+	ctlAddress    string
+	ctlOutput     string
+	ctlAuthMethod string
+	ctlToken      string
+	ctlTLS        bool
+	ctlCAFile     string
+	ctlCertFile   string
+	ctlKeyFile    string
+	ctlServerName string
+	ctlTimeout    time.Duration
+)
+
+// ctlCmd represents the ctl command.
+var ctlCmd = &cobra.Command{
+	Use:   "ctl",
+	Short: "Inspect a running GatewayD instance through its gRPC API",
+	Long: `Inspect a running GatewayD instance through its gRPC API.
+
+The address, the TLS and the authentication settings of the API are read from
+the global config file, if it exists, and can be overridden by the flags. The
+token can also be given in the ` + APITokenEnvVar + ` environment variable.`,
+	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+		// The errors of the API calls aren't caused by the usage of the command.
+		cmd.SilenceUsage = true
+	},
+	Run: func(cmd *cobra.Command, _ []string) {
+		if err := cmd.Help(); err != nil {
+			cmd.PrintErr(err)
+		}
+	},
+}
+
+// ctlClient is a connection to the gRPC API of a running GatewayD instance.
+type ctlClient struct {
+	conn   *grpc.ClientConn
+	admin  v1.GatewayDAdminAPIServiceClient
+	health grpc_health_v1.HealthClient
+}
+
+// tokenCredentials sends the API token in the header of the authentication method.
+type tokenCredentials struct {
+	method string
+	token  string
+}
+
+func (t tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	if t.method == config.AuthMethodAPIKey {
+		return map[string]string{config.APIKeyHeader: t.token}, nil
+	}
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+// RequireTransportSecurity is false, so that the token can be sent to an API that
+// listens on localhost or on a unix domain socket without TLS.
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+func init() {
+	rootCmd.AddCommand(ctlCmd)
+
+	ctlCmd.PersistentFlags().StringVarP(
+		&globalConfigFile, // Already exists in run.go
+		"config", "c", config.GetDefaultConfigFilePath(config.GlobalConfigFilename),
+		"Global config file, which the API settings are read from")
+	ctlCmd.PersistentFlags().StringVar(
+		&ctlNetwork, "network", config.DefaultGRPCAPINetwork, "Network of the gRPC API (tcp or unix)")
+	ctlCmd.PersistentFlags().StringVarP(
+		&ctlAddress, "address", "a", config.DefaultGRPCAPIAddress, "Address of the gRPC API")
+	ctlCmd.PersistentFlags().StringVarP(
+		&ctlOutput, "output", "o", TableOutput, "Output format (table, json or yaml)")
+	ctlCmd.PersistentFlags().StringVar(
+		&ctlAuthMethod, "auth-method", config.AuthMethodBearer,
+		"Header that the token is sent in (bearer or apiKey)")
+	ctlCmd.PersistentFlags().StringVar(
+		&ctlToken, "token", "", "API token (default $"+APITokenEnvVar+")")
+	ctlCmd.PersistentFlags().BoolVar(
+		&ctlTLS, "tls", false, "Connect to the gRPC API over TLS")
+	ctlCmd.PersistentFlags().StringVar(
+		&ctlCAFile, "ca-file", "", "CA certificate that verifies the API certificate")
+	ctlCmd.PersistentFlags().StringVar(
+		&ctlCertFile, "cert-file", "", "Client certificate for mTLS")
+	ctlCmd.PersistentFlags().StringVar(
+		&ctlKeyFile, "key-file", "", "Private key of the client certificate for mTLS")
+	ctlCmd.PersistentFlags().StringVar(
+		&ctlServerName, "server-name", "", "Server name that the API certificate is verified against")
+	ctlCmd.PersistentFlags().DurationVar(
+		&ctlTimeout, "timeout", DefaultCtlTimeout, "Timeout of the API calls")
+}
+
+// loadCtlSettings applies the API settings of the global config file to the flags
+// that aren't set explicitly.
+func loadCtlSettings(cmd *cobra.Command) error {
+	if _, err := os.Stat(globalConfigFile); err != nil {
+		if cmd.Flags().Changed("config") {
+			return fmt.Errorf("failed to read the global config file: %w", err)
+		}
+		return nil
+	}
+
+	conf := config.NewConfig(context.TODO(), config.Config{GlobalConfigFile: globalConfigFile})
+	if err := conf.LoadDefaults(context.TODO()); err != nil {
+		return err
+	}
+	if err := conf.LoadGlobalConfigFile(context.TODO()); err != nil {
+		return err
+	}
+	if err := conf.LoadGlobalEnvVars(context.TODO()); err != nil {
+		return err
+	}
+	if err := conf.UnmarshalGlobalConfig(context.TODO()); err != nil {
+		return err
+	}
+
+	apiConfig := conf.Global.API
+	if !cmd.Flags().Changed("network") && apiConfig.GRPCNetwork != "" {
+		ctlNetwork = apiConfig.GRPCNetwork
+	}
+	if !cmd.Flags().Changed("address") && apiConfig.GRPCAddress != "" {
+		ctlAddress = apiConfig.GRPCAddress
+	}
+	if !cmd.Flags().Changed("tls") {
+		ctlTLS = apiConfig.EnableTLS
+	}
+	if !cmd.Flags().Changed("auth-method") && apiConfig.Auth.Method != config.AuthMethodNone &&
+		apiConfig.Auth.Method != "" {
+		ctlAuthMethod = apiConfig.Auth.Method
+	}
+
+	return nil
+}
+
+// newCtlClient connects to the gRPC API. The connection is established lazily on the first call.
+func newCtlClient(cmd *cobra.Command) (*ctlClient, error) {
+	if err := validateOutput(ctlOutput); err != nil {
+		return nil, err
+	}
+	if err := loadCtlSettings(cmd); err != nil {
+		return nil, err
+	}
+
+	transport := insecure.NewCredentials()
+	if ctlTLS {
+		tlsConfig, err := ctlTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		transport = credentials.NewTLS(tlsConfig)
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(transport)}
+
+	token := ctlToken
+	if token == "" {
+		token = os.Getenv(APITokenEnvVar)
+	}
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials{ctlAuthMethod, token}))
+	}
+
+	target := ctlAddress
+	if ctlNetwork == "unix" {
+		target = "unix:" + ctlAddress
+	}
+
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the gRPC API: %w", err)
+	}
+
+	return &ctlClient{
+		conn:   conn,
+		admin:  v1.NewGatewayDAdminAPIServiceClient(conn),
+		health: grpc_health_v1.NewHealthClient(conn),
+	}, nil
+}
+
+// ctlTLSConfig returns the TLS configuration of the connection to the gRPC API.
+func ctlTLSConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: ctlServerName,
+	}
+
+	if ctlCAFile != "" {
+		pem, err := os.ReadFile(ctlCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the CA file: %w", err)
+		}
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificates found in the CA file") //nolint:goerr113
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if ctlCertFile != "" || ctlKeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(ctlCertFile, ctlKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// context returns the context of an API call, which times out after the timeout.
+func (c *ctlClient) context(cmd *cobra.Command) (context.Context, context.CancelFunc) {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithTimeout(ctx, ctlTimeout)
+}
+
+// Close closes the connection to the gRPC API.
+func (c *ctlClient) Close() {
+	_ = c.conn.Close()
+}
+
+// validateOutput checks that the output format is supported.
+func validateOutput(output string) error {
+	switch output {
+	case TableOutput, JSONOutput, YAMLOutput:
+		return nil
+	default:
+		return fmt.Errorf( //nolint:goerr113
+			"invalid output format %q, expected %s, %s or %s", output, TableOutput, JSONOutput, YAMLOutput)
+	}
+}
+
+// printOutput prints the value in the output format. The value is a proto message or
+// a value that can be marshaled to JSON. The table is printed by the given function,
+// or as YAML if there is no function, e.g. for the nested configuration.
+func printOutput(cmd *cobra.Command, value interface{}, table func(writer io.Writer)) error {
+	// Convert the value to plain data, so that JSON and YAML have the same field names.
+	var data interface{}
+	if message, ok := value.(proto.Message); ok {
+		raw, err := protojson.Marshal(message)
+		if err != nil {
+			return fmt.Errorf("failed to marshal the output: %w", err)
+		}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return fmt.Errorf("failed to marshal the output: %w", err)
+		}
+	} else {
+		data = value
+	}
+
+	switch {
+	case ctlOutput == JSONOutput:
+		raw, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal the output: %w", err)
+		}
+		cmd.Println(string(raw))
+	case ctlOutput == YAMLOutput || table == nil:
+		raw, err := yaml.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("failed to marshal the output: %w", err)
+		}
+		cmd.Print(string(raw))
+	default:
+		writer := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0) //nolint:gomnd
+		table(writer)
+		if err := writer.Flush(); err != nil {
+			return fmt.Errorf("failed to print the output: %w", err)
+		}
+	}
+
+	return nil
+}
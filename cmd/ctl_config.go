@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	v1 "github.com/gatewayd-io/gatewayd/api/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var ctlPluginConfig bool
+
+// ctlConfigCmd represents the ctl config command.
+var ctlConfigCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect the configuration of the running GatewayD",
+	Run: func(cmd *cobra.Command, _ []string) {
+		if err := cmd.Help(); err != nil {
+			cmd.PrintErr(err)
+		}
+	},
+}
+
+// ctlConfigGetCmd represents the ctl config get command.
+var ctlConfigGetCmd = &cobra.Command{
+	Use:   "get [group]",
+	Short: "Show the global config, or only the objects of the group, e.g. default",
+	Long: `Show the global config, or only the objects of the group, e.g. default.
+The config is nested, so it is printed as YAML unless JSON is requested.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := newCtlClient(cmd)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		ctx, cancel := client.context(cmd)
+		defer cancel()
+
+		var conf *structpb.Struct
+		if ctlPluginConfig {
+			conf, err = client.admin.GetPluginConfig(ctx, &emptypb.Empty{})
+		} else {
+			group := &v1.Group{}
+			if len(args) > 0 {
+				group.GroupName = &args[0]
+			}
+			conf, err = client.admin.GetGlobalConfig(ctx, group)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get the config: %w", err)
+		}
+
+		return printOutput(cmd, conf, nil)
+	},
+}
+
+func init() {
+	ctlCmd.AddCommand(ctlConfigCmd)
+	ctlConfigCmd.AddCommand(ctlConfigGetCmd)
+
+	ctlConfigGetCmd.Flags().BoolVarP(
+		&ctlPluginConfig, "plugins", "p", false, "Show the plugin config instead of the global config")
+}
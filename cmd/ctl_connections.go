@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ctlConnectionsCmd represents the ctl connections command.
+var ctlConnectionsCmd = &cobra.Command{
+	Use:   "connections",
+	Short: "List the client connections of the proxies",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, err := newCtlClient(cmd)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		ctx, cancel := client.context(cmd)
+		defer cancel()
+
+		connections, err := client.admin.ListConnections(ctx, &emptypb.Empty{})
+		if err != nil {
+			return fmt.Errorf("failed to list the connections: %w", err)
+		}
+
+		return printOutput(cmd, connections, func(writer io.Writer) {
+			fmt.Fprintln(writer,
+				"ID\tPROXY\tCLIENT\tBACKEND\tUSER\tDATABASE\tTLS\tSTATE\tAGE\tBYTES IN\tBYTES OUT")
+			for _, conn := range connections.GetConnections() {
+				fmt.Fprintf(writer, "%d\t%s\t%s\t%s\t%s\t%s\t%t\t%s\t%s\t%d\t%d\n",
+					conn.GetId(),
+					conn.GetProxy(),
+					conn.GetClientAddress(),
+					conn.GetBackendAddress(),
+					conn.GetUser(),
+					conn.GetDatabase(),
+					conn.GetTls(),
+					conn.GetState(),
+					conn.GetAge().AsDuration().Round(time.Second),
+					conn.GetBytesIn(),
+					conn.GetBytesOut())
+			}
+		})
+	},
+}
+
+func init() {
+	ctlCmd.AddCommand(ctlConnectionsCmd)
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ctlPluginsCmd represents the ctl plugins command.
+var ctlPluginsCmd = &cobra.Command{
+	Use:   "plugins",
+	Short: "List the loaded plugins",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, err := newCtlClient(cmd)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		ctx, cancel := client.context(cmd)
+		defer cancel()
+
+		plugins, err := client.admin.GetPlugins(ctx, &emptypb.Empty{})
+		if err != nil {
+			return fmt.Errorf("failed to get the plugins: %w", err)
+		}
+
+		return printOutput(cmd, plugins, func(writer io.Writer) {
+			fmt.Fprintln(writer, "NAME\tVERSION\tHOOKS\tDISABLED HOOKS\tBREAKER\tREMOTE URL")
+			for _, plugin := range plugins.GetConfigs() {
+				fmt.Fprintf(writer, "%s\t%s\t%d\t%d\t%s\t%s\n",
+					plugin.GetId().GetName(),
+					plugin.GetId().GetVersion(),
+					len(plugin.GetHooks()),
+					len(plugin.GetDisabledHooks()),
+					plugin.GetCircuitBreaker().GetState(),
+					plugin.GetId().GetRemoteUrl())
+			}
+		})
+	},
+}
+
+func init() {
+	ctlCmd.AddCommand(ctlPluginsCmd)
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ctlPoolsCmd represents the ctl pools command.
+var ctlPoolsCmd = &cobra.Command{
+	Use:   "pools",
+	Short: "List the connection pools",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, err := newCtlClient(cmd)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		ctx, cancel := client.context(cmd)
+		defer cancel()
+
+		pools, err := client.admin.GetPools(ctx, &emptypb.Empty{})
+		if err != nil {
+			return fmt.Errorf("failed to get the pools: %w", err)
+		}
+
+		return printOutput(cmd, pools, func(writer io.Writer) {
+			fmt.Fprintln(writer, "NAME\tCAP\tSIZE")
+			for _, name := range sortedFields(pools) {
+				pool := pools.GetFields()[name].GetStructValue().GetFields()
+				fmt.Fprintf(writer, "%s\t%d\t%d\n",
+					name, int(pool["cap"].GetNumberValue()), int(pool["size"].GetNumberValue()))
+			}
+		})
+	},
+}
+
+func init() {
+	ctlCmd.AddCommand(ctlPoolsCmd)
+}
+
+// sortedFields returns the sorted names of the fields of the struct.
+func sortedFields(value *structpb.Struct) []string {
+	names := maps.Keys(value.GetFields())
+	slices.Sort(names)
+	return names
+}
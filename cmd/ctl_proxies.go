@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ctlProxiesCmd represents the ctl proxies command.
+var ctlProxiesCmd = &cobra.Command{
+	Use:   "proxies",
+	Short: "List the proxies and the number of their connections",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, err := newCtlClient(cmd)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		ctx, cancel := client.context(cmd)
+		defer cancel()
+
+		proxies, err := client.admin.GetProxies(ctx, &emptypb.Empty{})
+		if err != nil {
+			return fmt.Errorf("failed to get the proxies: %w", err)
+		}
+
+		return printOutput(cmd, proxies, func(writer io.Writer) {
+			fmt.Fprintln(writer, "NAME\tAVAILABLE\tBUSY\tTOTAL")
+			for _, name := range sortedFields(proxies) {
+				proxy := proxies.GetFields()[name].GetStructValue().GetFields()
+				fmt.Fprintf(writer, "%s\t%d\t%d\t%d\n",
+					name,
+					len(proxy["available"].GetListValue().GetValues()),
+					len(proxy["busy"].GetListValue().GetValues()),
+					int(proxy["total"].GetNumberValue()))
+			}
+		})
+	},
+}
+
+func init() {
+	ctlCmd.AddCommand(ctlProxiesCmd)
+}
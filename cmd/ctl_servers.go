@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ctlServersCmd represents the ctl servers command.
+var ctlServersCmd = &cobra.Command{
+	Use:   "servers",
+	Short: "List the servers",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, err := newCtlClient(cmd)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		ctx, cancel := client.context(cmd)
+		defer cancel()
+
+		servers, err := client.admin.GetServers(ctx, &emptypb.Empty{})
+		if err != nil {
+			return fmt.Errorf("failed to get the servers: %w", err)
+		}
+
+		return printOutput(cmd, servers, func(writer io.Writer) {
+			fmt.Fprintln(writer, "NAME\tNETWORK\tADDRESS\tSTATUS\tTICK INTERVAL")
+			for _, name := range sortedFields(servers) {
+				server := servers.GetFields()[name].GetStructValue().GetFields()
+				status := "stopped"
+				if config.Status(server["status"].GetNumberValue()) == config.Running {
+					status = "running"
+				}
+				fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n",
+					name,
+					server["network"].GetStringValue(),
+					server["address"].GetStringValue(),
+					status,
+					time.Duration(server["tickInterval"].GetNumberValue()))
+			}
+		})
+	},
+}
+
+func init() {
+	ctlCmd.AddCommand(ctlServersCmd)
+}
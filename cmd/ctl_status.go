@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gatewayd-io/gatewayd/api"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Status is the status of a running GatewayD instance.
+type Status struct {
+	Version   string `json:"version"`
+	Liveness  string `json:"liveness"`
+	Readiness string `json:"readiness"`
+}
+
+// ctlStatusCmd represents the ctl status command.
+var ctlStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the version, liveness and readiness of the GatewayD",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, err := newCtlClient(cmd)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		ctx, cancel := client.context(cmd)
+		defer cancel()
+
+		version, err := client.admin.Version(ctx, &emptypb.Empty{})
+		if err != nil {
+			return fmt.Errorf("failed to get the version: %w", err)
+		}
+		liveness, err := client.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		if err != nil {
+			return fmt.Errorf("failed to check the liveness: %w", err)
+		}
+		readiness, err := client.health.Check(
+			ctx, &grpc_health_v1.HealthCheckRequest{Service: api.ReadinessService})
+		if err != nil {
+			return fmt.Errorf("failed to check the readiness: %w", err)
+		}
+
+		status := Status{
+			Version:   version.GetVersion(),
+			Liveness:  liveness.GetStatus().String(),
+			Readiness: readiness.GetStatus().String(),
+		}
+		return printOutput(cmd, status, func(writer io.Writer) {
+			fmt.Fprintln(writer, "VERSION\tLIVENESS\tREADINESS")
+			fmt.Fprintf(writer, "%s\t%s\t%s\n", status.Version, status.Liveness, status.Readiness)
+		})
+	},
+}
+
+func init() {
+	ctlCmd.AddCommand(ctlStatusCmd)
+}
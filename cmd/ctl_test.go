@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/gatewayd-io/gatewayd/act"
+	"github.com/gatewayd-io/gatewayd/api"
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/gatewayd-io/gatewayd/network"
+	"github.com/gatewayd-io/gatewayd/plugin"
+	"github.com/gatewayd-io/gatewayd/pool"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// startCtlTestAPI starts a gRPC API with a pool, a proxy and a server, which only
+// accepts the calls with the given read-only token or with the given admin token.
+func startCtlTestAPI(t *testing.T, address, token, adminToken string) {
+	t.Helper()
+
+	logger := zerolog.Nop()
+	defaultPool := pool.NewPool(context.Background(), config.DefaultPoolSize)
+	pluginRegistry := plugin.NewRegistry(
+		context.Background(),
+		plugin.Registry{
+			ActRegistry: act.NewActRegistry(act.Registry{
+				Logger:               logger,
+				PolicyTimeout:        config.DefaultPolicyTimeout,
+				DefaultActionTimeout: config.DefaultActionTimeout,
+				Signals:              act.BuiltinSignals(),
+				Policies:             act.BuiltinPolicies(),
+				Actions:              act.BuiltinActions(),
+				DefaultPolicyName:    config.DefaultPolicy,
+			}),
+			DevMode:       true,
+			Logger:        logger,
+			Compatibility: config.DefaultCompatibilityPolicy,
+			StartTimeout:  config.DefaultPluginStartTimeout,
+		},
+	)
+	proxy := network.NewProxy(
+		context.Background(),
+		network.Proxy{
+			AvailableConnections: defaultPool,
+			Logger:               logger,
+			PluginRegistry:       pluginRegistry,
+			PluginTimeout:        config.DefaultPluginTimeout,
+			HealthCheckPeriod:    config.DefaultHealthCheckPeriod,
+			ClientConfig:         &config.Client{},
+		},
+	)
+	servers := map[string]*network.Server{
+		config.Default: network.NewServer(
+			context.Background(),
+			network.Server{
+				Logger:         logger,
+				Proxy:          proxy,
+				PluginRegistry: pluginRegistry,
+				PluginTimeout:  config.DefaultPluginTimeout,
+				Network:        "tcp",
+				Address:        "localhost:15432",
+				TickInterval:   config.DefaultTickInterval,
+			},
+		),
+	}
+
+	conf := config.NewConfig(context.Background(), config.Config{})
+	require.Nil(t, conf.LoadDefaults(context.Background()))
+	require.Nil(t, conf.UnmarshalGlobalConfig(context.Background()))
+
+	apiObj := &api.API{
+		Options: &api.Options{
+			Logger:      logger,
+			GRPCNetwork: "tcp",
+			GRPCAddress: address,
+			Servers:     servers,
+			Auth: config.APIAuth{
+				Method: config.AuthMethodBearer,
+				Credentials: []config.APICredential{
+					{Name: "ctl", Token: token, Role: "readonly"},
+					{Name: "admin", Token: adminToken, Role: "admin"},
+				},
+			},
+		},
+		Config:         conf,
+		PluginRegistry: pluginRegistry,
+		Pools:          map[string]*pool.Pool{config.Default: defaultPool},
+		Proxies:        map[string]*network.Proxy{config.Default: proxy},
+		Servers:        servers,
+	}
+	grpcServer := api.NewGRPCServer(api.GRPCServer{
+		API:           apiObj,
+		HealthChecker: &api.HealthChecker{Servers: servers},
+	})
+	require.NotNil(t, grpcServer)
+	go grpcServer.Start()
+	t.Cleanup(func() {
+		grpcServer.Shutdown(context.Background())
+	})
+}
+
+// Test_ctlCmd tests the ctl commands against a running gRPC API.
+func Test_ctlCmd(t *testing.T) {
+	address := "localhost:19193"
+	token := "ctl-token"
+	adminToken := "ctl-admin-token"
+	startCtlTestAPI(t, address, token, adminToken)
+
+	ctl := func(args ...string) (string, error) {
+		return executeCommandC(rootCmd, append(
+			[]string{"ctl"}, append(args, "--address", address, "--token", token)...)...)
+	}
+
+	output, err := ctl("status", "-o", "table")
+	require.NoError(t, err)
+	assert.Contains(t, output, "VERSION")
+	assert.Contains(t, output, config.Version)
+	assert.Contains(t, output, "NOT_SERVING")
+
+	output, err = ctl("status", "-o", "json")
+	require.NoError(t, err)
+	var status Status
+	require.NoError(t, json.Unmarshal([]byte(output), &status))
+	assert.Equal(t, config.Version, status.Version)
+	assert.Equal(t, "NOT_SERVING", status.Liveness)
+
+	output, err = ctl("pools", "-o", "table")
+	require.NoError(t, err)
+	assert.Contains(t, output, "NAME     CAP  SIZE")
+	assert.Contains(t, output, "default  10   0")
+
+	output, err = ctl("proxies", "-o", "yaml")
+	require.NoError(t, err)
+	var proxies map[string]map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(output), &proxies))
+	assert.Equal(t, 0, proxies[config.Default]["total"])
+
+	output, err = ctl("servers", "-o", "table")
+	require.NoError(t, err)
+	assert.Contains(t, output, ":15432")
+	assert.Contains(t, output, "stopped")
+
+	output, err = ctl("plugins", "-o", "table")
+	require.NoError(t, err)
+	assert.Contains(t, output, "NAME  VERSION")
+
+	output, err = ctl("connections", "-o", "json")
+	require.NoError(t, err)
+	assert.JSONEq(t, "{}", output)
+
+	// The config can only be read by the admins.
+	_, err = ctl("config", "get", config.Default, "-o", "table")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "PermissionDenied")
+	output, err = executeCommandC(rootCmd,
+		"ctl", "config", "get", config.Default, "--address", address, "--token", adminToken, "-o", "table")
+	require.NoError(t, err)
+	var conf map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(output), &conf))
+	assert.Contains(t, conf, "pools")
+
+	// The token is required.
+	_, err = executeCommandC(
+		rootCmd, "ctl", "pools", "--address", address, "--token", "wrong", "-o", "table")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Unauthenticated")
+
+	_, err = ctl("pools", "-o", "xml")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid output format")
+}
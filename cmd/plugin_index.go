@@ -0,0 +1,281 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	gerr "github.com/gatewayd-io/gatewayd/errors"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// IndexFilenames are the filenames of the plugin index that are looked up when the
+// index is a directory.
+var IndexFilenames = []string{"index.yaml", "index.yml", "index.json"}
+
+// PluginIndex is a catalog of plugin archives, which is served over HTTP(S) or from a
+// directory, so that the plugins can be mirrored for air-gapped environments. It is in
+// the YAML or the JSON format, and the URLs of the archives and their signatures can be
+// relative to the index.
+type PluginIndex struct {
+	Plugins []PluginIndexEntry `json:"plugins" yaml:"plugins"`
+}
+
+// PluginIndexEntry is a plugin archive of a version of a plugin for an OS and an
+// architecture. The OS and the architecture match any platform if they aren't set.
+type PluginIndexEntry struct {
+	Name      string `json:"name"                yaml:"name"`
+	Version   string `json:"version"             yaml:"version"`
+	OS        string `json:"os,omitempty"        yaml:"os,omitempty"`
+	Arch      string `json:"arch,omitempty"      yaml:"arch,omitempty"`
+	URL       string `json:"url"                 yaml:"url"`
+	Checksum  string `json:"checksum"            yaml:"checksum"`
+	Signature string `json:"signature,omitempty" yaml:"signature,omitempty"`
+}
+
+// isRemote returns true if the location is an HTTP(S) URL.
+func isRemote(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
+
+// resolveLocation resolves the location relative to the base location, which is a URL
+// or a local path. The absolute locations are returned as they are.
+func resolveLocation(base, location string) (string, error) {
+	if isRemote(location) || filepath.IsAbs(location) {
+		return location, nil
+	}
+
+	if isRemote(base) {
+		baseURL, err := url.Parse(base)
+		if err != nil {
+			return "", fmt.Errorf("invalid URL %q: %w", base, err)
+		}
+		ref, err := url.Parse(location)
+		if err != nil {
+			return "", fmt.Errorf("invalid URL %q: %w", location, err)
+		}
+		return baseURL.ResolveReference(ref).String(), nil
+	}
+
+	if info, err := os.Stat(base); err == nil && info.IsDir() {
+		return filepath.Join(base, location), nil
+	}
+	return filepath.Join(filepath.Dir(base), location), nil
+}
+
+// withSuffix returns the location with the suffix appended to its path, e.g. the URL of
+// the checksum of an archive, so that the query of a URL, e.g. a signed download URL,
+// stays after the path.
+func withSuffix(location, suffix string) (string, error) {
+	if !isRemote(location) {
+		return location + suffix, nil
+	}
+
+	parsed, err := url.Parse(location)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL %q: %w", location, err)
+	}
+	parsed.Path += suffix
+	if parsed.RawPath != "" {
+		parsed.RawPath += url.PathEscape(suffix)
+	}
+	return parsed.String(), nil
+}
+
+// openLocation opens the local file, or downloads the file at the URL.
+func openLocation(location string) (io.ReadCloser, error) {
+	if !isRemote(location) {
+		file, err := os.Open(location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", location, err)
+		}
+		return file, nil
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, location, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL %q: %w", location, err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", location, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf( //nolint:goerr113
+			"failed to download %s: %s", location, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// readLocation reads the file at the location, which must not be larger than MaxFileSize.
+func readLocation(location string) ([]byte, error) {
+	reader, err := openLocation(location)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	contents, err := io.ReadAll(io.LimitReader(reader, MaxFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", location, err)
+	}
+	if int64(len(contents)) > MaxFileSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", location, MaxFileSize) //nolint:goerr113
+	}
+	return contents, nil
+}
+
+// downloadLocation copies the file at the location to the output directory, and returns
+// the path of the copy.
+func downloadLocation(location, outputDir string) (string, *gerr.GatewayDError) {
+	contents, err := readLocation(location)
+	if err != nil {
+		return "", gerr.ErrDownloadFailed.Wrap(err)
+	}
+
+	filename := filepath.Base(location)
+	if parsed, err := url.Parse(location); err == nil && isRemote(location) {
+		filename = path.Base(parsed.Path)
+	}
+
+	filePath := filepath.Join(outputDir, filename)
+	if err := os.WriteFile(filePath, contents, FilePermissions); err != nil {
+		return "", gerr.ErrDownloadFailed.Wrap(err)
+	}
+	return filePath, nil
+}
+
+// checksumOf returns the checksum of the file in the checksums, which are in the format
+// of sha256sum, or a single checksum.
+func checksumOf(checksums, filename string) string {
+	for _, line := range strings.Split(checksums, "\n") {
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 1:
+			return fields[0]
+		case len(fields) >= NumParts && strings.TrimPrefix(fields[1], "*") == filename:
+			return fields[0]
+		}
+	}
+	return ""
+}
+
+// findChecksum returns the checksum of the archive at the URL from the checksums.txt
+// file next to it, or from the archive URL with the .sha256 extension.
+func findChecksum(archiveURL, filename string) (string, error) {
+	checksumsURL, err := resolveLocation(archiveURL, "checksums.txt")
+	if err != nil {
+		return "", err
+	}
+	checksumURL, err := withSuffix(archiveURL, ".sha256")
+	if err != nil {
+		return "", err
+	}
+
+	var errs []error
+	for _, resolved := range []string{checksumsURL, checksumURL} {
+		checksums, err := readLocation(resolved)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if sum := checksumOf(string(checksums), filename); sum != "" {
+			return sum, nil
+		}
+		errs = append(errs, fmt.Errorf("%s has no checksum of %s", resolved, filename)) //nolint:goerr113
+	}
+	return "", errors.Join(errs...)
+}
+
+// archiveName returns the name of the plugin from the filename of its archive, which is
+// the part before the OS, e.g. gatewayd-plugin-cache-linux-amd64-v0.1.0.tar.gz.
+func archiveName(filename string) string {
+	name := strings.TrimSuffix(
+		strings.TrimSuffix(filename, string(ExtensionTarGz)), string(ExtensionZip))
+	if before, _, found := strings.Cut(name, "-"+runtime.GOOS); found {
+		return before
+	}
+	return name
+}
+
+// loadPluginIndex reads the plugin index, which is a URL, a file, or a directory that
+// contains one of the IndexFilenames. It returns the location of the index file, which
+// the URLs of the archives are relative to.
+func loadPluginIndex(location string) (*PluginIndex, string, error) {
+	if info, err := os.Stat(location); err == nil && info.IsDir() {
+		for _, filename := range IndexFilenames {
+			if _, err := os.Stat(filepath.Join(location, filename)); err == nil {
+				location = filepath.Join(location, filename)
+				break
+			}
+		}
+	}
+
+	contents, err := readLocation(location)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// YAML is a superset of JSON, so both formats are parsed by the YAML parser.
+	var index PluginIndex
+	if err := yamlv3.Unmarshal(contents, &index); err != nil {
+		return nil, "", fmt.Errorf("failed to parse the plugin index: %w", err)
+	}
+	return &index, location, nil
+}
+
+// Versions returns the entries of the versions of the plugin for the current platform.
+func (i *PluginIndex) Versions(name string) []PluginIndexEntry {
+	var entries []PluginIndexEntry
+	for _, entry := range i.Plugins {
+		if entry.Name == name &&
+			(entry.OS == "" || entry.OS == runtime.GOOS) &&
+			(entry.Arch == "" || entry.Arch == runtime.GOARCH) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// Find returns the entry of the latest version of the plugin for the current platform
+// in the version range, e.g. "v0.2.4", "^0.2" or "latest".
+func (i *PluginIndex) Find(name, version string) (*PluginIndexEntry, error) {
+	versionRange := "*"
+	if version != "" && version != LatestVersion {
+		versionRange = version
+	}
+	constraint, err := semver.NewConstraint(versionRange)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q: %w", version, err)
+	}
+
+	var (
+		found        *PluginIndexEntry
+		foundVersion *semver.Version
+	)
+	for _, entry := range i.Versions(name) {
+		entryVersion, err := semver.NewVersion(entry.Version)
+		if err != nil || !constraint.Check(entryVersion) {
+			continue
+		}
+		if found == nil || entryVersion.GreaterThan(foundVersion) {
+			found, foundVersion = &entry, entryVersion
+		}
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf( //nolint:goerr113
+			"%s@%s for %s/%s is not in the plugin index", name, version, runtime.GOOS, runtime.GOARCH)
+	}
+	return found, nil
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test_PluginIndex tests that the latest version of a plugin in the version range is
+// found in the plugin index for the current platform.
+func Test_PluginIndex(t *testing.T) {
+	dir := t.TempDir()
+	index := `{"plugins": [
+		{"name": "cache", "version": "v0.1.0", "url": "cache-0.1.0.tar.gz", "checksum": "a"},
+		{"name": "cache", "version": "v0.2.0", "os": "` + runtime.GOOS + `", "arch": "` +
+		runtime.GOARCH + `", "url": "cache-0.2.0.tar.gz", "checksum": "b"},
+		{"name": "cache", "version": "v0.3.0", "os": "plan9", "url": "cache-0.3.0.tar.gz"},
+		{"name": "other", "version": "v1.0.0", "url": "https://example.com/other.tar.gz"}
+	]}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.json"), []byte(index), FilePermissions))
+
+	pluginIndex, location, err := loadPluginIndex(dir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "index.json"), location)
+
+	entry, err := pluginIndex.Find("cache", LatestVersion)
+	require.NoError(t, err)
+	assert.Equal(t, "v0.2.0", entry.Version)
+
+	entry, err = pluginIndex.Find("cache", "~0.1")
+	require.NoError(t, err)
+	assert.Equal(t, "v0.1.0", entry.Version)
+
+	_, err = pluginIndex.Find("cache", "v0.3.0")
+	assert.Error(t, err)
+	_, err = pluginIndex.Find("unknown", "")
+	assert.Error(t, err)
+
+	// The URLs are relative to the index.
+	archive, err := resolveLocation(location, entry.URL)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "cache-0.1.0.tar.gz"), archive)
+	archive, err = resolveLocation("https://example.com/mirror/index.yaml", entry.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/mirror/cache-0.1.0.tar.gz", archive)
+	archive, err = resolveLocation(location, "https://example.com/other.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/other.tar.gz", archive)
+}
+
+// Test_findChecksum tests that the checksum of an archive is found next to its URL.
+func Test_findChecksum(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/a/checksums.txt", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("1234  other.tar.gz\nabcd *plugin-linux-amd64.tar.gz\n"))
+	})
+	mux.HandleFunc("/b/plugin.tar.gz.sha256", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ef01\n"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	sum, err := findChecksum(server.URL+"/a/plugin-linux-amd64.tar.gz", "plugin-linux-amd64.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "abcd", sum)
+
+	sum, err = findChecksum(server.URL+"/b/plugin.tar.gz", "plugin.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "ef01", sum)
+
+	// The extension is added to the path of a signed URL, not to its query.
+	sum, err = findChecksum(server.URL+"/b/plugin.tar.gz?token=1", "plugin.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "ef01", sum)
+
+	_, err = findChecksum(server.URL+"/c/plugin.tar.gz", "plugin.tar.gz")
+	assert.ErrorContains(t, err, "404")
+
+	// The archive is downloaded to the output directory.
+	filePath, gErr := downloadLocation(server.URL+"/b/plugin.tar.gz.sha256?token=1", t.TempDir())
+	require.Nil(t, gErr)
+	assert.Equal(t, "plugin.tar.gz.sha256", filepath.Base(filePath))
+}
+
+// Test_withSuffix tests that the suffix is added to the path of a URL, before its query.
+func Test_withSuffix(t *testing.T) {
+	location, err := withSuffix("https://mirror/plugin.tar.gz?X-Amz-Signature=abc#top", ".sig")
+	require.NoError(t, err)
+	assert.Equal(t, "https://mirror/plugin.tar.gz.sig?X-Amz-Signature=abc#top", location)
+
+	location, err = withSuffix("https://mirror/a%2Fb/plugin.tar.gz", ".sha256")
+	require.NoError(t, err)
+	assert.Equal(t, "https://mirror/a%2Fb/plugin.tar.gz.sha256", location)
+
+	location, err = withSuffix("plugins/plugin.tar.gz", ".minisig")
+	require.NoError(t, err)
+	assert.Equal(t, "plugins/plugin.tar.gz.minisig", location)
+}
+
+func Test_archiveName(t *testing.T) {
+	assert.Equal(t, "gatewayd-plugin-cache", archiveName(
+		"gatewayd-plugin-cache-"+runtime.GOOS+"-"+runtime.GOARCH+"-v0.2.4.tar.gz"))
+	assert.Equal(t, "plugin", archiveName("plugin.zip"))
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"archive/tar"
 	"archive/zip"
+	"bytes"
 	"compress/gzip"
 	"context"
 	"errors"
@@ -16,10 +17,12 @@ import (
 	"runtime"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/codingsince1985/checksum"
 	"github.com/gatewayd-io/gatewayd/config"
 	gerr "github.com/gatewayd-io/gatewayd/errors"
+	"github.com/gatewayd-io/gatewayd/plugin"
 	"github.com/getsentry/sentry-go"
 	"github.com/google/go-github/v53/github"
 	"github.com/spf13/cast"
@@ -43,6 +46,7 @@ const (
 	ExecFilePermissions         os.FileMode    = 0o755
 	ExecFileMask                os.FileMode    = 0o111
 	MaxFileSize                 int64          = 1024 * 1024 * 100 // 100 MB
+	DownloadTimeout             time.Duration  = 5 * time.Minute
 	BackupFileExt               string         = ".bak"
 	DefaultPluginConfigFilename string         = "./gatewayd_plugin.yaml"
 	GitHubURLPrefix             string         = "github.com/"
@@ -52,6 +56,8 @@ const (
 	SourceUnknown               Source         = "unknown"
 	SourceFile                  Source         = "file"
 	SourceGitHub                Source         = "github"
+	SourceURL                   Source         = "url"
+	SourceIndex                 Source         = "index"
 	ExtensionZip                Extension      = ".zip"
 	ExtensionTarGz              Extension      = ".tar.gz"
 	Global                      configFileType = "global"
@@ -68,13 +74,19 @@ var (
 	pluginName               string
 	overwriteConfig          bool
 	skipPathSlipVerification bool
+	pluginIndex              string
+	frozen                   bool
 )
 
+// httpClient downloads the plugins, their checksums, their signatures and the plugin
+// indexes. Unlike http.DefaultClient, it gives up on the servers that stop responding.
+var httpClient = &http.Client{Timeout: DownloadTimeout}
+
 // pluginInstallCmd represents the plugin install command.
 var pluginInstallCmd = &cobra.Command{
 	Use:     "install",
-	Short:   "Install a plugin from a local archive or a GitHub repository",
-	Example: "  gatewayd plugin install <github.com/gatewayd-io/gatewayd-plugin-cache@latest|/path/to/plugin[.zip|.tar.gz]>", //nolint:lll
+	Short:   "Install a plugin from a local archive, a URL, a plugin index or a GitHub repository",
+	Example: "  gatewayd plugin install <github.com/gatewayd-io/gatewayd-plugin-cache@latest|/path/to/plugin[.zip|.tar.gz]|https://mirror/plugin[.zip|.tar.gz]>\n  gatewayd plugin install --index <https://mirror/index.yaml|/path/to/mirror> gatewayd-plugin-cache@latest", //nolint:lll
 	Run: func(cmd *cobra.Command, args []string) {
 		// Enable Sentry.
 		if enableSentry {
@@ -212,6 +224,10 @@ func init() {
 		&pluginConfigFile, // Already exists in run.go
 		"plugin-config", "p", config.GetDefaultConfigFilePath(config.PluginsConfigFilename),
 		"Plugin config file")
+	pluginInstallCmd.Flags().StringVarP(
+		&globalConfigFile, // Already exists in run.go
+		"config", "c", config.GetDefaultConfigFilePath(config.GlobalConfigFilename),
+		"Global config file, which has the signature policy and the trusted keys")
 	pluginInstallCmd.Flags().StringVarP(
 		&pluginOutputDir, "output-dir", "o", "./plugins", "Output directory for the plugin")
 	pluginInstallCmd.Flags().BoolVar(
@@ -231,6 +247,10 @@ func init() {
 		&overwriteConfig, "overwrite-config", true, "Overwrite the existing plugins configuration file (overrides --update, only used for installing from the plugins configuration file)") //nolint:lll
 	pluginInstallCmd.Flags().BoolVar(
 		&skipPathSlipVerification, "skip-path-slip-verification", false, "Skip path slip verification when extracting the plugin archive from a TRUSTED source") //nolint:lll
+	pluginInstallCmd.Flags().StringVar(
+		&pluginIndex, "index", "", "URL, file or directory of a plugin index to install the plugins by name@version from") //nolint:lll
+	pluginInstallCmd.Flags().BoolVar(
+		&frozen, "frozen", false, "Install the plugins in the lock file, and fail if they don't match it")
 	pluginInstallCmd.Flags().BoolVar(
 		&enableSentry, "sentry", true, "Enable Sentry") // Already exists in run.go
 }
@@ -268,45 +288,32 @@ func extractZip(filename, dest string) ([]string, *gerr.GatewayDError) {
 			outFilename := filepath.Join(filepath.Clean(dest), filepath.Clean(fileOrDir.Name))
 
 			// Check for ZipSlip.
-			if !skipPathSlipVerification &&
-				strings.HasPrefix(outFilename, string(os.PathSeparator)) {
+			if !skipPathSlipVerification && !isInDirectory(dest, outFilename) {
 				return nil, gerr.ErrExtractFailed.Wrap(
 					fmt.Errorf("illegal file path: %s", outFilename))
 			}
 
-			// Create the file.
-			outFile, err := os.Create(outFilename)
-			if err != nil {
-				return nil, gerr.ErrExtractFailed.Wrap(err)
-			}
-			defer outFile.Close()
-
 			// Open the file in the zip archive.
 			fileRc, err := fileOrDir.Open()
 			if err != nil {
-				os.Remove(outFilename)
 				return nil, gerr.ErrExtractFailed.Wrap(err)
 			}
 
-			// Copy the file contents.
-			if _, err := io.Copy(outFile, io.LimitReader(fileRc, MaxFileSize)); err != nil {
-				os.Remove(outFilename)
-				return nil, gerr.ErrExtractFailed.Wrap(err)
-			}
-
-			fileMode := fileOrDir.FileInfo().Mode()
 			// Set the file permissions.
+			fileMode := fileOrDir.FileInfo().Mode()
+			perm := FilePermissions
 			if fileMode.IsRegular() && fileMode&ExecFileMask != 0 {
-				if err := os.Chmod(outFilename, ExecFilePermissions); err != nil {
-					return nil, gerr.ErrExtractFailed.Wrap(err)
-				}
-			} else {
-				if err := os.Chmod(outFilename, FilePermissions); err != nil {
-					return nil, gerr.ErrExtractFailed.Wrap(err)
-				}
+				perm = ExecFilePermissions
+			}
+
+			// Copy the file contents.
+			err = writeFileAtomically(outFilename, io.LimitReader(fileRc, MaxFileSize), perm)
+			fileRc.Close()
+			if err != nil {
+				return nil, gerr.ErrExtractFailed.Wrap(err)
 			}
 
-			filenames = append(filenames, outFile.Name())
+			filenames = append(filenames, outFilename)
 		default:
 			return nil, gerr.ErrExtractFailed.Wrap(
 				fmt.Errorf("unknown file type: %s", fileOrDir.Name))
@@ -366,37 +373,24 @@ func extractTarGz(filename, dest string) ([]string, *gerr.GatewayDError) {
 			outFilename := path.Join(filepath.Clean(dest), filepath.Clean(header.Name))
 
 			// Check for TarSlip.
-			if !skipPathSlipVerification &&
-				strings.HasPrefix(outFilename, string(os.PathSeparator)) {
+			if !skipPathSlipVerification && !isInDirectory(dest, outFilename) {
 				return nil, gerr.ErrExtractFailed.Wrap(
 					fmt.Errorf("illegal file path: %s", outFilename))
 			}
 
-			// Create the file.
-			outFile, err := os.Create(outFilename)
-			if err != nil {
-				return nil, gerr.ErrExtractFailed.Wrap(err)
+			// Set the file permissions
+			fileMode := header.FileInfo().Mode()
+			perm := FilePermissions
+			if fileMode.IsRegular() && fileMode&ExecFileMask != 0 {
+				perm = ExecFilePermissions
 			}
-			defer outFile.Close()
 
-			if _, err := io.Copy(outFile, io.LimitReader(tarReader, MaxFileSize)); err != nil {
-				os.Remove(outFilename)
+			if err := writeFileAtomically(
+				outFilename, io.LimitReader(tarReader, MaxFileSize), perm); err != nil {
 				return nil, gerr.ErrExtractFailed.Wrap(err)
 			}
 
-			fileMode := header.FileInfo().Mode()
-			// Set the file permissions
-			if fileMode.IsRegular() && fileMode&ExecFileMask != 0 {
-				if err := os.Chmod(outFilename, ExecFilePermissions); err != nil {
-					return nil, gerr.ErrExtractFailed.Wrap(err)
-				}
-			} else {
-				if err := os.Chmod(outFilename, FilePermissions); err != nil {
-					return nil, gerr.ErrExtractFailed.Wrap(err)
-				}
-			}
-
-			filenames = append(filenames, outFile.Name())
+			filenames = append(filenames, outFilename)
 		default:
 			return nil, gerr.ErrExtractFailed.Wrap(
 				fmt.Errorf("unknown file type: %s", header.Name))
@@ -406,6 +400,50 @@ func extractTarGz(filename, dest string) ([]string, *gerr.GatewayDError) {
 	return filenames, nil
 }
 
+// stagedName returns the path of the extracted file relative to the staging directory,
+// which is its path relative to the output directory once it is moved there.
+func stagedName(stagingDir, filename string) string {
+	if rel, err := filepath.Rel(stagingDir, filename); err == nil {
+		return rel
+	}
+	return filepath.Base(filename)
+}
+
+// isInDirectory returns true if the file is in the directory, so that the files of an
+// archive are not extracted outside of the output directory.
+func isInDirectory(dir, filename string) bool {
+	rel, err := filepath.Rel(filepath.Clean(dir), filepath.Clean(filename))
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(os.PathSeparator))
+}
+
+// writeFileAtomically writes the contents to a temporary file next to the file and renames
+// it, so that the file is either replaced or left as it is, even if it is a running plugin.
+func writeFileAtomically(filename string, contents io.Reader, perm os.FileMode) error {
+	tempFile, err := os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create a temporary file: %w", err)
+	}
+	// The temporary file is already renamed if the file is written.
+	defer os.Remove(tempFile.Name())
+
+	if _, err := io.Copy(tempFile, contents); err != nil {
+		tempFile.Close()
+		return fmt.Errorf("failed to write %s: %w", filename, err)
+	}
+	if err := tempFile.Chmod(perm); err != nil {
+		tempFile.Close()
+		return fmt.Errorf("failed to set the permissions of %s: %w", filename, err)
+	}
+	if err := tempFile.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", filename, err)
+	}
+
+	if err := os.Rename(tempFile.Name(), filename); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", filename, err)
+	}
+	return nil
+}
+
 // findAsset finds the release asset that matches the given criteria in the release.
 func findAsset(release *github.RepositoryRelease, match func(string) bool) (string, string, int64) {
 	if release == nil {
@@ -430,7 +468,7 @@ func downloadFile(
 ) (string, *gerr.GatewayDError) {
 	// Download the plugin.
 	readCloser, redirectURL, err := client.Repositories.DownloadReleaseAsset(
-		context.Background(), account, pluginName, releaseID, http.DefaultClient)
+		context.Background(), account, pluginName, releaseID, httpClient)
 	if err != nil {
 		return "", gerr.ErrDownloadFailed.Wrap(err)
 	}
@@ -446,7 +484,7 @@ func downloadFile(
 			return "", gerr.ErrDownloadFailed.Wrap(err)
 		}
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := httpClient.Do(req)
 		if err != nil {
 			return "", gerr.ErrDownloadFailed.Wrap(err)
 		}
@@ -487,6 +525,87 @@ func downloadFile(
 	return filePath, nil
 }
 
+// signatureConfig returns the signature policy and the trusted keys of the global
+// configuration file, or the default policy if the file doesn't exist. They aren't read
+// from the plugins configuration file, which is changed by installing the plugins.
+func signatureConfig() (config.SignaturePolicy, []plugin.TrustedKey, error) {
+	globalConfig, err := os.ReadFile(globalConfigFile)
+	if os.IsNotExist(err) {
+		return config.DefaultSignaturePolicy, nil, nil
+	} else if err != nil {
+		return "", nil, fmt.Errorf("failed to read the global configuration file: %w", err)
+	}
+
+	var localGlobalConfig map[string]interface{}
+	if err := yamlv3.Unmarshal(globalConfig, &localGlobalConfig); err != nil {
+		return "", nil, fmt.Errorf("failed to unmarshal the global configuration file: %w", err)
+	}
+
+	pluginSignatures := config.PluginSignatures{Policy: string(config.DefaultSignaturePolicy)}
+	if signatures, ok := localGlobalConfig["pluginSignatures"]; ok {
+		signaturesConfig := cast.ToStringMap(signatures)
+		if policy, ok := signaturesConfig["policy"]; ok {
+			pluginSignatures.Policy = cast.ToString(policy)
+		}
+		pluginSignatures.TrustedKeys = cast.ToStringSlice(signaturesConfig["trustedKeys"])
+	}
+
+	policy, err := pluginSignatures.GetPolicy()
+	if err != nil {
+		return "", nil, err //nolint:wrapcheck
+	}
+	keys, err := plugin.ParseTrustedKeys(pluginSignatures.TrustedKeys)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid trusted keys: %w", err)
+	}
+
+	return policy, keys, nil
+}
+
+// verifySignature verifies the detached signature of the file with the trusted keys of
+// the global configuration file, according to its signature policy. It returns false
+// if the file must not be installed.
+func verifySignature(cmd *cobra.Command, filename, signatureFilename string) bool {
+	policy, keys, err := signatureConfig()
+	if err != nil {
+		cmd.Println("There was an error reading the signature policy: ", err)
+		return false
+	}
+
+	switch {
+	case policy == config.SignaturesDisabled:
+		return true
+	case signatureFilename == "" && policy == config.SignaturesRequired:
+		cmd.Println("The signature of", filename, "could not be found, but it is required")
+		return false
+	case signatureFilename == "":
+		// The files that aren't signed are only refused if the signatures are required.
+		return true
+	case len(keys) == 0 && policy != config.SignaturesRequired:
+		cmd.Println("There are no trusted keys, so the signature of", filename, "is not verified")
+		return true
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		cmd.Println("There was an error reading the file: ", err)
+		return false
+	}
+	signature, err := os.ReadFile(signatureFilename)
+	if err != nil {
+		cmd.Println("There was an error reading the signature file: ", err)
+		return false
+	}
+
+	if err := plugin.VerifySignature(data, signature, keys); err != nil {
+		cmd.Println("Signature verification failed: ", err)
+		return false
+	}
+
+	cmd.Println("Signature verification passed for", filename)
+	return true
+}
+
 // deleteFiles deletes the files in the toBeDeleted list.
 func deleteFiles(toBeDeleted []string) {
 	for _, filename := range toBeDeleted {
@@ -512,10 +631,19 @@ func detectSource(path string) Source {
 		return SourceFile
 	}
 
-	// Check if the path is a URL.
-	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") || strings.HasPrefix(path, GitHubURLPrefix) { //nolint:lll
+	// Check if the path is a GitHub repository or a URL.
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(path, "http://"), "https://")
+	if strings.HasPrefix(trimmed, GitHubURLPrefix) {
 		return SourceGitHub
 	}
+	if isRemote(path) {
+		return SourceURL
+	}
+
+	// Otherwise, the path is the name@version of a plugin in the plugin index.
+	if pluginIndex != "" && path != "" {
+		return SourceIndex
+	}
 
 	return SourceUnknown
 }
@@ -535,25 +663,47 @@ func installPlugin(cmd *cobra.Command, pluginURL string) {
 		// This is a list of files that will be deleted after the plugin is installed.
 		toBeDeleted []string
 
-		// Source of the plugin: file or GitHub.
+		// Source of the plugin: file, URL, plugin index or GitHub.
 		source = detectSource(pluginURL)
 
 		// The extension of the archive based on the OS: .zip or .tar.gz.
 		archiveExt = getFileExtension()
 
+		// The URL that the plugin is installed from, which is recorded in the lock file.
+		requestedURL = pluginURL
+
 		releaseID         int64
 		downloadURL       string
+		assetURL          string
+		locked            *PluginLockEntry
 		pluginFilename    string
 		checksumsFilename string
+		signatureFilename string
+		pluginVersion     string
 		account           string
 		err               error
 		client            *github.Client
 	)
 
+	// The frozen installs use the exact versions and checksums in the lock file.
+	if frozen {
+		lock, err := readPluginLock(lockFilename(pluginConfigFile))
+		if err != nil {
+			cmd.Println("There was an error reading the lock file: ", err)
+			return
+		}
+		if locked = lock.find(lockName(cmd, pluginURL, source)); locked == nil {
+			cmd.Println("The plugin could not be found in the lock file:", pluginURL)
+			return
+		}
+		pluginURL = locked.lockedURL(pluginURL, source)
+	}
+
 	switch source {
 	case SourceFile:
 		// Pull the plugin from a local archive.
 		pluginFilename = filepath.Clean(pluginURL)
+		assetURL = pluginFilename
 		if _, err := os.Stat(pluginFilename); os.IsNotExist(err) {
 			cmd.Println("The plugin file could not be found")
 			return
@@ -563,6 +713,12 @@ func installPlugin(cmd *cobra.Command, pluginURL string) {
 			cmd.Println("Plugin name not specified")
 			return
 		}
+
+		// Verify the signature next to the plugin archive, if it is signed.
+		signatureFilename, _ = plugin.FindSignature(pluginFilename, "")
+		if !verifySignature(cmd, pluginFilename, signatureFilename) {
+			return
+		}
 	case SourceGitHub:
 		// Strip scheme from the plugin URL.
 		pluginURL = strings.TrimPrefix(strings.TrimPrefix(pluginURL, "http://"), "https://")
@@ -584,7 +740,7 @@ func installPlugin(cmd *cobra.Command, pluginURL string) {
 		}
 
 		// Get the plugin version.
-		pluginVersion := LatestVersion
+		pluginVersion = LatestVersion
 		splittedURL = strings.Split(pluginURL, "@")
 		// If the version is not specified, use the latest version.
 		if len(splittedURL) < NumParts {
@@ -610,7 +766,7 @@ func installPlugin(cmd *cobra.Command, pluginURL string) {
 		}
 
 		// Get the release artifact from GitHub.
-		client = github.NewClient(nil)
+		client = github.NewClient(httpClient)
 		var release *github.RepositoryRelease
 
 		if pluginVersion == LatestVersion || pluginVersion == "" {
@@ -632,6 +788,7 @@ func installPlugin(cmd *cobra.Command, pluginURL string) {
 			cmd.Println("The plugin could not be found in the release assets")
 			return
 		}
+		pluginVersion = release.GetTagName()
 
 		// Create the output directory if it doesn't exist.
 		if err := os.MkdirAll(pluginOutputDir, FolderPermissions); err != nil {
@@ -645,6 +802,7 @@ func installPlugin(cmd *cobra.Command, pluginURL string) {
 				strings.Contains(name, runtime.GOARCH) &&
 				strings.Contains(name, string(archiveExt))
 		})
+		assetURL = downloadURL
 		if downloadURL != "" && releaseID != 0 {
 			cmd.Println("Downloading", downloadURL)
 			filePath, gErr := downloadFile(
@@ -716,6 +874,34 @@ func installPlugin(cmd *cobra.Command, pluginURL string) {
 			}
 		}
 
+		// Find and download the signature of the plugin archive, if it is signed.
+		signatureFilename, downloadURL, releaseID = findAsset(release, func(name string) bool {
+			return slices.ContainsFunc(plugin.SignatureExtensions, func(ext string) bool {
+				return name == pluginFilename+ext
+			})
+		})
+		if signatureFilename != "" && downloadURL != "" && releaseID != 0 {
+			cmd.Println("Downloading", downloadURL)
+			filePath, gErr := downloadFile(
+				client, account, pluginName, releaseID, signatureFilename, pluginOutputDir)
+			toBeDeleted = append(toBeDeleted, filePath)
+			if gErr != nil {
+				cmd.Println("Download failed: ", gErr)
+				if cleanup {
+					deleteFiles(toBeDeleted)
+				}
+				return
+			}
+			signatureFilename = filePath
+		}
+
+		if !verifySignature(cmd, pluginFilename, signatureFilename) {
+			if cleanup {
+				deleteFiles(toBeDeleted)
+			}
+			return
+		}
+
 		if pullOnly {
 			cmd.Println("Plugin binary downloaded to", pluginFilename)
 			// Only the checksums file will be deleted if the --pull-only flag is set.
@@ -724,6 +910,131 @@ func installPlugin(cmd *cobra.Command, pluginURL string) {
 			}
 			return
 		}
+	case SourceURL, SourceIndex:
+		// Pull the plugin from a URL, or from the URL in the plugin index, which can
+		// also be a local path, e.g. of a mirror for air-gapped environments.
+		archiveURL := pluginURL
+		expectedSum := ""
+		signatureURLs := []string{}
+		if source == SourceIndex {
+			index, indexLocation, err := loadPluginIndex(pluginIndex)
+			if err != nil {
+				cmd.Println("There was an error reading the plugin index: ", err)
+				return
+			}
+
+			name, version, _ := strings.Cut(pluginURL, "@")
+			entry, err := index.Find(name, version)
+			if err != nil {
+				cmd.Println("The plugin could not be found: ", err)
+				return
+			}
+			pluginName = entry.Name
+			pluginVersion = entry.Version
+			expectedSum = entry.Checksum
+
+			if archiveURL, err = resolveLocation(indexLocation, entry.URL); err != nil {
+				cmd.Println("Invalid plugin URL in the plugin index: ", err)
+				return
+			}
+			if entry.Signature != "" {
+				signatureURL, err := resolveLocation(indexLocation, entry.Signature)
+				if err != nil {
+					cmd.Println("Invalid signature URL in the plugin index: ", err)
+					return
+				}
+				signatureURLs = append(signatureURLs, signatureURL)
+			}
+		} else {
+			for _, ext := range plugin.SignatureExtensions {
+				signatureURL, err := withSuffix(archiveURL, ext)
+				if err != nil {
+					cmd.Println("Invalid plugin URL: ", err)
+					return
+				}
+				signatureURLs = append(signatureURLs, signatureURL)
+			}
+		}
+
+		// Create the output directory if it doesn't exist.
+		if err := os.MkdirAll(pluginOutputDir, FolderPermissions); err != nil {
+			cmd.Println("There was an error creating the output directory: ", err)
+			return
+		}
+
+		assetURL = archiveURL
+		cmd.Println("Downloading", archiveURL)
+		filePath, gErr := downloadLocation(archiveURL, pluginOutputDir)
+		if gErr != nil {
+			cmd.Println("Download failed: ", gErr)
+			return
+		}
+		toBeDeleted = append(toBeDeleted, filePath)
+		pluginFilename = filePath
+		cmd.Println("File downloaded to", filePath)
+		cmd.Println("Download completed successfully")
+
+		// The name of the plugin is in the plugin index, or in the name of the archive.
+		if source == SourceURL && !cmd.Flags().Changed("name") {
+			pluginName = archiveName(filepath.Base(filePath))
+		}
+
+		// The checksum of the plugin index, or from the checksum file next to the archive.
+		if expectedSum == "" {
+			if source == SourceIndex {
+				cmd.Println("The checksum of the plugin could not be found in the plugin index")
+			} else if expectedSum, err = findChecksum(archiveURL, filepath.Base(filePath)); err != nil {
+				cmd.Println("The checksum file could not be found: ", err)
+			}
+			if expectedSum == "" {
+				if cleanup {
+					deleteFiles(toBeDeleted)
+				}
+				return
+			}
+		}
+
+		sum, err := checksum.SHA256sum(filePath)
+		if err != nil {
+			cmd.Println("There was an error calculating the checksum: ", err)
+			return
+		}
+		if !strings.EqualFold(sum, expectedSum) {
+			cmd.Println("Checksum verification failed")
+			if cleanup {
+				deleteFiles(toBeDeleted)
+			}
+			return
+		}
+		cmd.Println("Checksum verification passed")
+
+		// Download the signature of the plugin archive, if it is signed.
+		// The signature in the plugin index must exist.
+		for _, signatureURL := range signatureURLs {
+			signaturePath, gErr := downloadLocation(signatureURL, pluginOutputDir)
+			if gErr == nil {
+				toBeDeleted = append(toBeDeleted, signaturePath)
+				signatureFilename = signaturePath
+				break
+			} else if source == SourceIndex {
+				cmd.Println("The signature could not be downloaded: ", gErr)
+				if cleanup {
+					deleteFiles(toBeDeleted)
+				}
+				return
+			}
+		}
+		if !verifySignature(cmd, pluginFilename, signatureFilename) {
+			if cleanup {
+				deleteFiles(toBeDeleted)
+			}
+			return
+		}
+
+		if pullOnly {
+			cmd.Println("Plugin archive downloaded to", pluginFilename)
+			return
+		}
 	case SourceUnknown:
 	default:
 		cmd.Println("Invalid URL or file path")
@@ -732,6 +1043,20 @@ func installPlugin(cmd *cobra.Command, pluginURL string) {
 	// NOTE: The rest of the code is executed regardless of the source,
 	// since the plugin binary is already available (or downloaded) at this point.
 
+	// Get the checksum of the plugin archive, which is recorded in the lock file.
+	archiveSum, err := checksum.SHA256sum(pluginFilename)
+	if err != nil {
+		cmd.Println("There was an error calculating the checksum: ", err)
+		return
+	}
+	if locked != nil && !strings.EqualFold(archiveSum, locked.ArchiveChecksum) {
+		cmd.Println("The checksum of the plugin archive doesn't match the lock file")
+		if cleanup {
+			deleteFiles(toBeDeleted)
+		}
+		return
+	}
+
 	// Create a new "gatewayd_plugins.yaml" file if it doesn't exist.
 	if _, err := os.Stat(pluginConfigFile); os.IsNotExist(err) {
 		generateConfig(cmd, Plugins, pluginConfigFile, false)
@@ -801,14 +1126,27 @@ func installPlugin(cmd *cobra.Command, pluginURL string) {
 		cmd.Println("Backup completed successfully")
 	}
 
+	// The archive is extracted to a staging directory in the output directory, so that
+	// the installed plugin is only replaced once the extracted binary is verified.
+	if err := os.MkdirAll(pluginOutputDir, FolderPermissions); err != nil {
+		cmd.Println("There was an error creating the output directory: ", err)
+		return
+	}
+	stagingDir, err := os.MkdirTemp(pluginOutputDir, ".install-*")
+	if err != nil {
+		cmd.Println("There was an error creating the staging directory: ", err)
+		return
+	}
+	defer os.RemoveAll(stagingDir)
+
 	// Extract the archive.
 	var filenames []string
 	var gErr *gerr.GatewayDError
 	switch archiveExt {
 	case ExtensionZip:
-		filenames, gErr = extractZip(pluginFilename, pluginOutputDir)
+		filenames, gErr = extractZip(pluginFilename, stagingDir)
 	case ExtensionTarGz:
-		filenames, gErr = extractTarGz(pluginFilename, pluginOutputDir)
+		filenames, gErr = extractTarGz(pluginFilename, stagingDir)
 	default:
 		cmd.Println("Invalid archive extension")
 		return
@@ -822,23 +1160,15 @@ func installPlugin(cmd *cobra.Command, pluginURL string) {
 		return
 	}
 
-	// Delete all the files except the extracted plugin binary,
-	// which will be deleted from the list further down.
-	toBeDeleted = append(toBeDeleted, filenames...)
-
 	// Find the extracted plugin binary.
-	localPath := ""
+	stagedPath := ""
 	pluginFileSum := ""
 	for _, filename := range filenames {
-		if strings.Contains(filename, pluginName) {
-			cmd.Println("Plugin binary extracted to", filename)
-
-			// Remove the plugin binary from the list of files to be deleted.
-			toBeDeleted = slices.DeleteFunc[[]string, string](toBeDeleted, func(s string) bool {
-				return s == filename
-			})
-
-			localPath = filename
+		isSignature := slices.ContainsFunc(plugin.SignatureExtensions, func(ext string) bool {
+			return strings.HasSuffix(filename, ext)
+		})
+		if strings.Contains(stagedName(stagingDir, filename), pluginName) && !isSignature {
+			stagedPath = filename
 			// Get the checksum for the extracted plugin binary.
 			// TODO: Should we verify the checksum using the checksum.txt file instead?
 			pluginFileSum, err = checksum.SHA256sum(filename)
@@ -850,6 +1180,64 @@ func installPlugin(cmd *cobra.Command, pluginURL string) {
 		}
 	}
 
+	// Keep the signature of the plugin binary, if it is signed, so that the plugin
+	// can be verified when it is loaded.
+	stagedSignature := ""
+	for _, ext := range plugin.SignatureExtensions {
+		if stagedPath != "" && slices.Contains(filenames, stagedPath+ext) {
+			stagedSignature = stagedPath + ext
+			break
+		}
+	}
+	if stagedPath != "" && !verifySignature(cmd, stagedPath, stagedSignature) {
+		if cleanup {
+			deleteFiles(toBeDeleted)
+		}
+		return
+	}
+
+	// The frozen installs only install the plugin binary that is in the lock file.
+	if locked != nil && !strings.EqualFold(pluginFileSum, locked.Checksum) {
+		cmd.Println("The checksum of the plugin binary doesn't match the lock file")
+		if cleanup {
+			deleteFiles(toBeDeleted)
+		}
+		return
+	}
+
+	// Move the verified files to the output directory, which replaces the installed plugin.
+	// The plugin binary is moved last, so that it is only replaced if the other files are.
+	localPath := ""
+	binarySignature := ""
+	staged := slices.DeleteFunc(slices.Clone(filenames), func(s string) bool {
+		return s == stagedPath
+	})
+	if stagedPath != "" {
+		staged = append(staged, stagedPath)
+	}
+	for _, filename := range staged {
+		outFilename := filepath.Join(pluginOutputDir, stagedName(stagingDir, filename))
+		if err := os.MkdirAll(filepath.Dir(outFilename), FolderPermissions); err != nil {
+			cmd.Println("There was an error creating the output directory: ", err)
+			return
+		}
+		if err := os.Rename(filename, outFilename); err != nil {
+			cmd.Println("There was an error moving the extracted file: ", err)
+			return
+		}
+
+		// Delete all the files except the plugin binary and its signature.
+		switch filename {
+		case stagedPath:
+			cmd.Println("Plugin binary extracted to", outFilename)
+			localPath = outFilename
+		case stagedSignature:
+			binarySignature = outFilename
+		default:
+			toBeDeleted = append(toBeDeleted, outFilename)
+		}
+	}
+
 	var contents string
 	if source == SourceGitHub {
 		// Get the list of files in the repository.
@@ -894,13 +1282,33 @@ func installPlugin(cmd *cobra.Command, pluginURL string) {
 	// Update the plugin's local path and checksum.
 	pluginConfig["localPath"] = localPath
 	pluginConfig["checksum"] = pluginFileSum
+	if source == SourceGitHub || source == SourceIndex {
+		pluginConfig["version"] = pluginVersion
+	}
+	// The verified signature of the binary is recorded, instead of the signature that the
+	// downloaded configuration or the previous version of the plugin points to.
+	if binarySignature != "" {
+		pluginConfig["signature"] = binarySignature
+	} else {
+		delete(pluginConfig, "signature")
+	}
 
-	// Add the plugin config to the list of plugin configs.
+	// Add the plugin config to the list of plugin configs. The settings of an installed
+	// plugin are kept when it is updated, and the new settings are added.
 	added := false
 	for idx, plugin := range pluginsList {
 		pluginInstance := cast.ToStringMap(plugin)
 		if pluginInstance["name"] == pluginName {
-			pluginsList[idx] = pluginConfig
+			for key, value := range pluginConfig {
+				if _, ok := pluginInstance[key]; !ok || slices.Contains(
+					[]string{"localPath", "checksum", "version", "signature"}, key) {
+					pluginInstance[key] = value
+				}
+			}
+			if binarySignature == "" {
+				delete(pluginInstance, "signature")
+			}
+			pluginsList[idx] = pluginInstance
 			added = true
 			break
 		}
@@ -921,10 +1329,28 @@ func installPlugin(cmd *cobra.Command, pluginURL string) {
 
 	// Write the YAML to the plugins config file if the --overwrite-config flag is set.
 	if overwriteConfig {
-		if err = os.WriteFile(pluginConfigFile, updatedPlugins, FilePermissions); err != nil {
+		if err = writeFileAtomically(
+			pluginConfigFile, bytes.NewReader(updatedPlugins), FilePermissions); err != nil {
 			cmd.Println("There was an error writing the plugins configuration file: ", err)
 			return
 		}
+
+		// Record the plugin in the lock file, unless the install is frozen to it.
+		if !frozen {
+			if err = lockPlugin(PluginLockEntry{
+				Name:            pluginName,
+				URL:             requestedURL,
+				Version:         config.If(source == SourceGitHub || source == SourceIndex, pluginVersion, ""),
+				OS:              runtime.GOOS,
+				Arch:            runtime.GOARCH,
+				AssetURL:        assetURL,
+				ArchiveChecksum: archiveSum,
+				Checksum:        pluginFileSum,
+			}); err != nil {
+				cmd.Println("There was an error writing the lock file: ", err)
+				return
+			}
+		}
 	}
 
 	// Delete the downloaded and extracted files, except the plugin binary,
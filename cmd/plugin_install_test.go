@@ -1,11 +1,18 @@
 package cmd
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
 	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
 
+	"github.com/gatewayd-io/gatewayd/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -42,6 +49,7 @@ func Test_pluginInstallCmd(t *testing.T) {
 	// Clean up.
 	assert.FileExists(t, "plugins/gatewayd-plugin-cache")
 	assert.FileExists(t, pluginTestConfigFile+BackupFileExt)
+	assert.FileExists(t, lockFilename(pluginTestConfigFile))
 	assert.NoFileExists(t, "gatewayd-plugin-cache-linux-amd64-v0.2.4.tar.gz")
 	assert.NoFileExists(t, "checksums.txt")
 	assert.NoFileExists(t, "plugins/LICENSE")
@@ -52,6 +60,7 @@ func Test_pluginInstallCmd(t *testing.T) {
 	require.NoError(t, os.RemoveAll("plugins/"))
 	require.NoError(t, os.Remove(pluginTestConfigFile))
 	require.NoError(t, os.Remove(pluginTestConfigFile+BackupFileExt))
+	require.NoError(t, os.Remove(lockFilename(pluginTestConfigFile)))
 }
 
 func Test_pluginInstallCmdAutomatedNoOverwrite(t *testing.T) {
@@ -88,3 +97,100 @@ func Test_pluginInstallCmdAutomatedNoOverwrite(t *testing.T) {
 	require.NoError(t, os.RemoveAll("plugins/"))
 	require.NoError(t, os.Remove(pluginTestConfigFile+BackupFileExt))
 }
+
+func Test_isInDirectory(t *testing.T) {
+	assert.True(t, isInDirectory("./plugins", "plugins/gatewayd-plugin-cache"))
+	assert.True(t, isInDirectory("/opt/plugins", "/opt/plugins/gatewayd-plugin-cache"))
+	assert.False(t, isInDirectory("./plugins", "plugins/../../etc/passwd"))
+	assert.False(t, isInDirectory("/opt/plugins", "/opt/plugins-other/plugin"))
+}
+
+// Test_signatureConfig tests that the signature policy of the plugin install command is
+// read from the global config, and that an unknown policy is an error.
+func Test_signatureConfig(t *testing.T) {
+	defer func(previous string) { globalConfigFile = previous }(globalConfigFile)
+	globalConfigFile = filepath.Join(t.TempDir(), "gatewayd.yaml")
+
+	policy, keys, err := signatureConfig()
+	require.NoError(t, err)
+	assert.Equal(t, config.DefaultSignaturePolicy, policy)
+	assert.Empty(t, keys)
+
+	require.NoError(t, os.WriteFile(globalConfigFile, []byte(
+		"pluginSignatures:\n  policy: required\n"), FilePermissions))
+	policy, _, err = signatureConfig()
+	require.NoError(t, err)
+	assert.Equal(t, config.SignaturesRequired, policy)
+
+	require.NoError(t, os.WriteFile(globalConfigFile, []byte(
+		"pluginSignatures:\n  policy: requried\n"), FilePermissions))
+	_, _, err = signatureConfig()
+	assert.ErrorContains(t, err, "requried")
+}
+
+// Test_pluginInstallCmdSignature tests that a plugin binary with an invalid signature
+// isn't installed, and that the installed plugin binary is left untouched.
+func Test_pluginInstallCmdSignature(t *testing.T) {
+	if getFileExtension() != ExtensionTarGz {
+		t.Skip("The test archives are tar.gz archives")
+	}
+	t.Cleanup(func() {
+		pluginName = ""
+		pluginOutputDir = "./plugins"
+		update = false
+	})
+	defer func(previous string) { globalConfigFile = previous }(globalConfigFile)
+
+	dir := t.TempDir()
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	keyFile := filepath.Join(dir, "trusted.pem")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(
+		&pem.Block{Type: "PUBLIC KEY", Bytes: der}), FilePermissions))
+	globalConfigFile = filepath.Join(dir, "gatewayd.yaml")
+	require.NoError(t, os.WriteFile(globalConfigFile, []byte(
+		"pluginSignatures:\n  trustedKeys:\n    - "+keyFile+"\n"), FilePermissions))
+	sign := func(data string) string {
+		return base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte(data)))
+	}
+
+	pluginTestConfigFile := filepath.Join(dir, "gatewayd_plugins.yaml")
+	require.NoError(t, os.WriteFile(pluginTestConfigFile, []byte("plugins: []\n"), FilePermissions))
+	binary := filepath.Join(dir, "plugins", "gatewayd-plugin-test")
+	archive := filepath.Join(dir, "gatewayd-plugin-test.tar.gz")
+	install := func() string {
+		output, err := executeCommandC(
+			rootCmd, "plugin", "install", "-p", pluginTestConfigFile, "-o", filepath.Dir(binary),
+			"--name", "gatewayd-plugin-test", "--update", "--overwrite-config", archive)
+		require.NoError(t, err, "plugin install should not return an error")
+		return output
+	}
+
+	// The plugin binary that is signed by a trusted key is installed.
+	writeTestArchive(t, archive, "v0.1.0", sign("v0.1.0"))
+	output := install()
+	assert.Contains(t, output, "Signature verification passed for")
+	assert.Contains(t, output, "Plugin installed successfully")
+
+	// The plugin binary whose signature doesn't match is refused, and the installed
+	// plugin binary and its signature are kept.
+	writeTestArchive(t, archive, "v0.2.0", sign("v0.1.0"))
+	output = install()
+	assert.Contains(t, output, "Signature verification failed")
+	assert.NotContains(t, output, "Plugin installed successfully")
+	contents, err := os.ReadFile(binary)
+	require.NoError(t, err)
+	assert.Equal(t, "v0.1.0", string(contents))
+	signature, err := os.ReadFile(binary + ".sig")
+	require.NoError(t, err)
+	assert.Equal(t, sign("v0.1.0"), string(signature))
+
+	// The extracted files are removed.
+	entries, err := os.ReadDir(filepath.Dir(binary))
+	require.NoError(t, err)
+	for _, entry := range entries {
+		assert.NotContains(t, entry.Name(), ".install-")
+	}
+}
@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// LockFileExt is the extension of the lock file, which is next to the plugins
+// configuration file, e.g. gatewayd_plugins.lock.
+const LockFileExt = ".lock"
+
+// lockFileHeader is written at the top of the lock file.
+const lockFileHeader = "# This file is generated by gatewayd plugin install. Do not edit it.\n"
+
+// PluginLock records the plugin archives and binaries that are installed on each
+// platform, so that every environment can install identical plugin binaries.
+type PluginLock struct {
+	Plugins []PluginLockEntry `yaml:"plugins"`
+}
+
+// PluginLockEntry is the plugin that is installed on a platform. The URL is the URL that
+// the plugin is installed from, and the asset URL is the URL of the archive.
+type PluginLockEntry struct {
+	Name            string `yaml:"name"`
+	URL             string `yaml:"url"`
+	Version         string `yaml:"version,omitempty"`
+	OS              string `yaml:"os"`
+	Arch            string `yaml:"arch"`
+	AssetURL        string `yaml:"assetURL"`
+	ArchiveChecksum string `yaml:"archiveChecksum"`
+	Checksum        string `yaml:"checksum"`
+}
+
+// lockFilename returns the path of the lock file of the plugins configuration file.
+func lockFilename(configFile string) string {
+	return strings.TrimSuffix(configFile, filepath.Ext(configFile)) + LockFileExt
+}
+
+// readPluginLock reads the lock file, which is empty if it doesn't exist.
+func readPluginLock(filename string) (*PluginLock, error) {
+	contents, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return &PluginLock{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read the lock file: %w", err)
+	}
+
+	var lock PluginLock
+	if err := yamlv3.Unmarshal(contents, &lock); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the lock file: %w", err)
+	}
+	return &lock, nil
+}
+
+// write writes the lock file atomically, with the plugins sorted by their names and
+// platforms, so that the changes of the lock file are easy to review.
+func (l *PluginLock) write(filename string) error {
+	slices.SortFunc(l.Plugins, func(a, b PluginLockEntry) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.OS, b.OS), cmp.Compare(a.Arch, b.Arch))
+	})
+
+	contents, err := yamlv3.Marshal(l)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the lock file: %w", err)
+	}
+	return writeFileAtomically(
+		filename, bytes.NewReader(append([]byte(lockFileHeader), contents...)), FilePermissions)
+}
+
+// find returns the locked plugin for the current platform, or nil.
+func (l *PluginLock) find(name string) *PluginLockEntry {
+	for idx, entry := range l.Plugins {
+		if entry.Name == name && entry.OS == runtime.GOOS && entry.Arch == runtime.GOARCH {
+			return &l.Plugins[idx]
+		}
+	}
+	return nil
+}
+
+// set adds or replaces the locked plugin for its platform.
+func (l *PluginLock) set(entry PluginLockEntry) {
+	if locked := l.find(entry.Name); locked != nil &&
+		entry.OS == runtime.GOOS && entry.Arch == runtime.GOARCH {
+		*locked = entry
+		return
+	}
+	l.Plugins = append(l.Plugins, entry)
+}
+
+// remove removes the locked plugin for all the platforms.
+func (l *PluginLock) remove(name string) {
+	l.Plugins = slices.DeleteFunc(l.Plugins, func(entry PluginLockEntry) bool {
+		return entry.Name == name
+	})
+}
+
+// lockName returns the name that the plugin at the URL is installed and locked with.
+func lockName(cmd *cobra.Command, pluginURL string, source Source) string {
+	repository, _, _ := strings.Cut(pluginURL, "@")
+	switch source {
+	case SourceGitHub:
+		return path.Base(repository)
+	case SourceIndex:
+		return repository
+	case SourceURL:
+		if parsed, err := url.Parse(pluginURL); err == nil && !cmd.Flags().Changed("name") {
+			return archiveName(path.Base(parsed.Path))
+		}
+		return pluginName
+	default:
+		return pluginName
+	}
+}
+
+// lockedURL returns the URL that installs the locked version of the plugin.
+func (e *PluginLockEntry) lockedURL(pluginURL string, source Source) string {
+	repository, _, _ := strings.Cut(pluginURL, "@")
+	switch source {
+	case SourceGitHub, SourceIndex:
+		return repository + "@" + e.Version
+	case SourceURL:
+		return e.AssetURL
+	default:
+		return pluginURL
+	}
+}
+
+// lockPlugin records the installed plugin in the lock file of the plugins configuration
+// file.
+func lockPlugin(entry PluginLockEntry) error {
+	filename := lockFilename(pluginConfigFile)
+	lock, err := readPluginLock(filename)
+	if err != nil {
+		return err
+	}
+	lock.set(entry)
+	return lock.write(filename)
+}
+
+// unlockPlugins removes the plugins from the lock file of the plugins configuration file,
+// if there is one.
+func unlockPlugins(names []string) error {
+	filename := lockFilename(pluginConfigFile)
+	if _, err := os.Stat(filename); os.IsNotExist(err) {
+		return nil
+	}
+
+	lock, err := readPluginLock(filename)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		lock.remove(name)
+	}
+	return lock.write(filename)
+}
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test_PluginLock tests that the plugins are locked for each platform.
+func Test_PluginLock(t *testing.T) {
+	lock := &PluginLock{}
+	lock.set(PluginLockEntry{Name: "cache", OS: "plan9", Arch: "386", Version: "v0.1.0"})
+	assert.Nil(t, lock.find("cache"))
+
+	lock.set(PluginLockEntry{Name: "cache", OS: runtime.GOOS, Arch: runtime.GOARCH, Version: "v0.1.0"})
+	lock.set(PluginLockEntry{Name: "cache", OS: runtime.GOOS, Arch: runtime.GOARCH, Version: "v0.2.0"})
+	require.Len(t, lock.Plugins, 2)
+	require.NotNil(t, lock.find("cache"))
+	assert.Equal(t, "v0.2.0", lock.find("cache").Version)
+
+	filename := filepath.Join(t.TempDir(), "gatewayd_plugins.lock")
+	require.NoError(t, lock.write(filename))
+	written, err := readPluginLock(filename)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, lock.Plugins, written.Plugins)
+
+	lock.remove("cache")
+	assert.Empty(t, lock.Plugins)
+
+	assert.Equal(t, "gatewayd-plugin-cache@v0.2.0", (&PluginLockEntry{Version: "v0.2.0"}).lockedURL(
+		"gatewayd-plugin-cache@latest", SourceIndex))
+	assert.Equal(t, "gatewayd_plugins.lock", lockFilename("gatewayd_plugins.yaml"))
+}
+
+func Test_pluginInstallCmdFrozen(t *testing.T) {
+	if getFileExtension() != ExtensionTarGz {
+		t.Skip("The test archives are tar.gz archives")
+	}
+	t.Cleanup(func() {
+		pluginIndex = ""
+		pluginName = ""
+		pluginOutputDir = "./plugins"
+		update = false
+		frozen = false
+	})
+
+	dir := t.TempDir()
+	// The binaries of the archives are their versions and builds.
+	writeIndex := func(build string, versions ...string) {
+		index := "plugins:\n"
+		for _, version := range versions {
+			filename := fmt.Sprintf("gatewayd-plugin-test-%s-%s-%s.tar.gz",
+				runtime.GOOS, runtime.GOARCH, version)
+			sum := writeTestArchive(t, filepath.Join(dir, filename), version+build)
+			index += fmt.Sprintf(
+				"  - {name: gatewayd-plugin-test, version: %s, url: %s, checksum: %s}\n",
+				version, filename, sum)
+		}
+		require.NoError(t, os.WriteFile(
+			filepath.Join(dir, "index.yaml"), []byte(index), FilePermissions))
+	}
+	writeIndex("", "v0.1.0", "v0.2.0")
+
+	pluginTestConfigFile := filepath.Join(dir, "gatewayd_plugins.yaml")
+	require.NoError(t, os.WriteFile(pluginTestConfigFile, []byte(`plugins:
+  - name: gatewayd-plugin-test
+    url: gatewayd-plugin-test@latest
+`), FilePermissions))
+	binary := filepath.Join(dir, "plugins", "gatewayd-plugin-test")
+	install := func(frozen bool) string {
+		output, err := executeCommandC(
+			rootCmd, "plugin", "install", "-p", pluginTestConfigFile, "--index", dir,
+			"-o", filepath.Dir(binary), "--update", "--overwrite-config",
+			fmt.Sprintf("--frozen=%t", frozen))
+		require.NoError(t, err, "plugin install should not return an error")
+		return output
+	}
+
+	// The installed plugin is recorded in the lock file.
+	assert.Contains(t, install(false), "Plugin installed successfully")
+	contents, err := os.ReadFile(binary)
+	require.NoError(t, err)
+	assert.Equal(t, "v0.2.0", string(contents))
+	binarySum := sha256.Sum256(contents)
+	archive := filepath.Join(dir, fmt.Sprintf(
+		"gatewayd-plugin-test-%s-%s-v0.2.0.tar.gz", runtime.GOOS, runtime.GOARCH))
+	archiveContents, err := os.ReadFile(archive)
+	require.NoError(t, err)
+	archiveSum := sha256.Sum256(archiveContents)
+
+	lock, err := readPluginLock(filepath.Join(dir, "gatewayd_plugins.lock"))
+	require.NoError(t, err)
+	assert.Equal(t, []PluginLockEntry{{
+		Name:            "gatewayd-plugin-test",
+		URL:             "gatewayd-plugin-test@latest",
+		Version:         "v0.2.0",
+		OS:              runtime.GOOS,
+		Arch:            runtime.GOARCH,
+		AssetURL:        archive,
+		ArchiveChecksum: hex.EncodeToString(archiveSum[:]),
+		Checksum:        hex.EncodeToString(binarySum[:]),
+	}}, lock.Plugins)
+
+	// The frozen install installs the locked version instead of the latest version.
+	writeIndex("", "v0.1.0", "v0.2.0", "v0.3.0")
+	assert.Contains(t, install(true), "Plugin installed successfully")
+	contents, err = os.ReadFile(binary)
+	require.NoError(t, err)
+	assert.Equal(t, "v0.2.0", string(contents))
+
+	// The frozen install fails if the locked version isn't in the plugin index anymore,
+	// or if its archive doesn't match the lock file.
+	writeIndex("", "v0.3.0")
+	assert.Contains(t, install(true), "The plugin could not be found")
+	writeIndex("-rebuilt", "v0.2.0")
+	output := install(true)
+	assert.Contains(t, output, "The checksum of the plugin archive doesn't match the lock file")
+	assert.NotContains(t, output, "Plugin installed successfully")
+	contents, err = os.ReadFile(binary)
+	require.NoError(t, err)
+	assert.Equal(t, "v0.2.0", string(contents))
+
+	// The frozen install fails if the extracted binary doesn't match the lock file,
+	// and the installed binary is left untouched.
+	writeIndex("", "v0.2.0")
+	lock, err = readPluginLock(filepath.Join(dir, "gatewayd_plugins.lock"))
+	require.NoError(t, err)
+	tamperedSum := sha256.Sum256([]byte("tampered"))
+	lock.Plugins[0].Checksum = hex.EncodeToString(tamperedSum[:])
+	require.NoError(t, lock.write(filepath.Join(dir, "gatewayd_plugins.lock")))
+	require.NoError(t, os.WriteFile(binary, []byte("installed"), ExecFilePermissions))
+	output = install(true)
+	assert.Contains(t, output, "The checksum of the plugin binary doesn't match the lock file")
+	assert.NotContains(t, output, "Plugin installed successfully")
+	contents, err = os.ReadFile(binary)
+	require.NoError(t, err)
+	assert.Equal(t, "installed", string(contents))
+
+	// The uninstalled plugins are removed from the lock file, and the frozen install
+	// fails for the plugins that aren't locked.
+	_, err = executeCommandC(
+		rootCmd, "plugin", "uninstall", "-p", pluginTestConfigFile, "gatewayd-plugin-test")
+	require.NoError(t, err, "plugin uninstall should not return an error")
+	lock, err = readPluginLock(filepath.Join(dir, "gatewayd_plugins.lock"))
+	require.NoError(t, err)
+	assert.Empty(t, lock.Plugins)
+
+	require.NoError(t, os.WriteFile(pluginTestConfigFile, []byte(`plugins:
+  - name: gatewayd-plugin-test
+    url: gatewayd-plugin-test@latest
+`), FilePermissions))
+	assert.Contains(t, install(true),
+		"The plugin could not be found in the lock file: gatewayd-plugin-test@latest")
+}
@@ -0,0 +1,267 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/getsentry/sentry-go"
+	"github.com/google/go-github/v53/github"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// MaxReleases is the number of the latest releases of a plugin that are checked.
+const MaxReleases = 100
+
+// pluginRelease is a released version of a plugin, and the URL that installs it.
+type pluginRelease struct {
+	version    *semver.Version
+	installURL string
+}
+
+// pluginVersions are the installed, the wanted and the latest versions of a plugin. The
+// wanted version is the latest version in the version range of the URL of the plugin.
+type pluginVersions struct {
+	name    string
+	current *semver.Version
+	wanted  *pluginRelease
+	latest  *pluginRelease
+}
+
+// pluginOutdatedCmd represents the plugin outdated command.
+var pluginOutdatedCmd = &cobra.Command{
+	Use:     "outdated",
+	Short:   "List the plugins that have newer releases",
+	Example: "  gatewayd plugin outdated -p gatewayd_plugins.yaml [--index <https://mirror/index.yaml|/path/to/mirror>]", //nolint:lll
+	Run: func(cmd *cobra.Command, _ []string) {
+		// Enable Sentry.
+		if enableSentry {
+			// Initialize Sentry.
+			err := sentry.Init(sentry.ClientOptions{
+				Dsn:              DSN,
+				TracesSampleRate: config.DefaultTraceSampleRate,
+				AttachStacktrace: config.DefaultAttachStacktrace,
+			})
+			if err != nil {
+				cmd.Println("Sentry initialization failed: ", err)
+				return
+			}
+
+			// Flush buffered events before the program terminates.
+			defer sentry.Flush(config.DefaultFlushTimeout)
+			// Recover from panics and report the error to Sentry.
+			defer sentry.Recover()
+		}
+
+		plugins, err := readPluginsList()
+		if err != nil {
+			cmd.Println(err)
+			return
+		}
+
+		writer := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0) //nolint:gomnd
+		outdated := 0
+		for _, plugin := range plugins {
+			versions, err := checkPluginVersions(plugin)
+			if err != nil {
+				cmd.Printf("Failed to check the versions of %s: %s\n", cast.ToString(plugin["name"]), err)
+				continue
+			}
+			if !versions.outdated() {
+				continue
+			}
+
+			if outdated == 0 {
+				fmt.Fprintln(writer, "NAME\tCURRENT\tWANTED\tLATEST")
+			}
+			outdated++
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n",
+				versions.name,
+				versionString(versions.current),
+				releaseString(versions.wanted),
+				releaseString(versions.latest))
+		}
+		if err := writer.Flush(); err != nil {
+			cmd.Println("Failed to print the outdated plugins: ", err)
+			return
+		}
+
+		if outdated == 0 {
+			cmd.Println("All plugins are up to date")
+		}
+	},
+}
+
+func init() {
+	pluginCmd.AddCommand(pluginOutdatedCmd)
+
+	pluginOutdatedCmd.Flags().StringVarP(
+		&pluginConfigFile, // Already exists in run.go
+		"plugin-config", "p", config.GetDefaultConfigFilePath(config.PluginsConfigFilename),
+		"Plugin config file")
+	pluginOutdatedCmd.Flags().StringVar(
+		&pluginIndex, "index", "", "URL, file or directory of a plugin index to check the plugins in") //nolint:lll
+	pluginOutdatedCmd.Flags().BoolVar(
+		&enableSentry, "sentry", true, "Enable Sentry") // Already exists in run.go
+}
+
+// readPluginsList returns the plugins in the plugins configuration file.
+func readPluginsList() ([]map[string]interface{}, error) {
+	pluginsConfig, err := os.ReadFile(pluginConfigFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the plugins configuration file: %w", err)
+	}
+
+	var localPluginsConfig map[string]interface{}
+	if err := yamlv3.Unmarshal(pluginsConfig, &localPluginsConfig); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the plugins configuration file: %w", err)
+	}
+
+	var plugins []map[string]interface{}
+	for _, plugin := range cast.ToSlice(localPluginsConfig["plugins"]) {
+		plugins = append(plugins, cast.ToStringMap(plugin))
+	}
+	return plugins, nil
+}
+
+// versionRange returns the semver range of the version in the URL of a plugin. A bare
+// version allows the updates that are compatible with it, e.g. v0.2.4 allows v0.2.9.
+func versionRange(version string) string {
+	if version == "" || version == LatestVersion {
+		return "*"
+	}
+	if _, err := semver.StrictNewVersion(strings.TrimPrefix(version, "v")); err == nil {
+		return "^" + version
+	}
+	// The GitHub URLs can have a v before the operator, e.g. v>=0.2.0.
+	return strings.TrimPrefix(version, "v")
+}
+
+// pluginReleases returns the releases of the plugin at the URL, which is a GitHub
+// repository or the name of a plugin in the plugin index.
+func pluginReleases(pluginURL string) ([]pluginRelease, error) {
+	repository, _, _ := strings.Cut(pluginURL, "@")
+
+	var releases []pluginRelease
+	switch detectSource(pluginURL) {
+	case SourceGitHub:
+		accountRepo := strings.Split(strings.TrimPrefix(strings.TrimPrefix(
+			strings.TrimPrefix(repository, "https://"), "http://"), GitHubURLPrefix), "/")
+		if len(accountRepo) != NumParts {
+			return nil, fmt.Errorf("invalid GitHub URL %q", pluginURL) //nolint:goerr113
+		}
+
+		client := github.NewClient(httpClient)
+		githubReleases, _, err := client.Repositories.ListReleases(
+			context.Background(), accountRepo[0], accountRepo[1],
+			&github.ListOptions{PerPage: MaxReleases})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the releases: %w", err)
+		}
+
+		for _, release := range githubReleases {
+			version, err := semver.NewVersion(release.GetTagName())
+			if err != nil || release.GetDraft() || release.GetPrerelease() {
+				continue
+			}
+			installURL := fmt.Sprintf("%s%s/%s@%s",
+				GitHubURLPrefix, accountRepo[0], accountRepo[1], release.GetTagName())
+			releases = append(releases, pluginRelease{version: version, installURL: installURL})
+		}
+	case SourceIndex:
+		index, _, err := loadPluginIndex(pluginIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		entries := index.Versions(repository)
+		if len(entries) == 0 {
+			return nil, fmt.Errorf("%s is not in the plugin index", repository) //nolint:goerr113
+		}
+		for _, entry := range entries {
+			version, err := semver.NewVersion(entry.Version)
+			if err != nil {
+				continue
+			}
+			releases = append(releases, pluginRelease{
+				version: version, installURL: entry.Name + "@" + entry.Version,
+			})
+		}
+	default:
+		return nil, fmt.Errorf( //nolint:goerr113
+			"the releases of %s can't be checked, since it isn't from GitHub or a plugin index",
+			pluginURL)
+	}
+	return releases, nil
+}
+
+// checkPluginVersions returns the installed, the wanted and the latest versions of the
+// plugin in the plugins configuration file. The installed version is unknown if the
+// plugin isn't installed from a release, or if it is installed from the latest release
+// without its version.
+func checkPluginVersions(plugin map[string]interface{}) (*pluginVersions, error) {
+	pluginURL := cast.ToString(plugin["url"])
+	_, version, _ := strings.Cut(pluginURL, "@")
+
+	versions := &pluginVersions{name: cast.ToString(plugin["name"])}
+	current := cast.ToString(plugin["version"])
+	if current == "" {
+		current = version
+	}
+	if installed, err := semver.StrictNewVersion(strings.TrimPrefix(current, "v")); err == nil {
+		versions.current = installed
+	}
+
+	constraint, err := semver.NewConstraint(versionRange(version))
+	if err != nil {
+		return nil, fmt.Errorf("invalid version range %q: %w", version, err)
+	}
+
+	releases, err := pluginReleases(pluginURL)
+	if err != nil {
+		return nil, err
+	}
+	for idx, release := range releases {
+		if versions.latest == nil || release.version.GreaterThan(versions.latest.version) {
+			versions.latest = &releases[idx]
+		}
+		if constraint.Check(release.version) &&
+			(versions.wanted == nil || release.version.GreaterThan(versions.wanted.version)) {
+			versions.wanted = &releases[idx]
+		}
+	}
+	return versions, nil
+}
+
+// outdated returns true if there is a newer release, or if the installed version is unknown.
+func (v *pluginVersions) outdated() bool {
+	return v.latest != nil && (v.current == nil || v.latest.version.GreaterThan(v.current))
+}
+
+// updatable returns true if there is a newer release in the version range of the plugin,
+// or if the installed version is unknown.
+func (v *pluginVersions) updatable() bool {
+	return v.wanted != nil && (v.current == nil || v.wanted.version.GreaterThan(v.current))
+}
+
+// versionString returns the version, or "unknown" if it isn't known.
+func versionString(version *semver.Version) string {
+	if version == nil {
+		return "unknown"
+	}
+	return "v" + version.String()
+}
+
+// releaseString returns the version of the release, or "-" if there is no release.
+func releaseString(release *pluginRelease) string {
+	if release == nil {
+		return "-"
+	}
+	return versionString(release.version)
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_versionRange(t *testing.T) {
+	assert.Equal(t, "*", versionRange(""))
+	assert.Equal(t, "*", versionRange(LatestVersion))
+	assert.Equal(t, "^v0.2.4", versionRange("v0.2.4"))
+	assert.Equal(t, ">=0.2.0", versionRange("v>=0.2.0"))
+	assert.Equal(t, "~0.2", versionRange("~0.2"))
+}
+
+func Test_pluginOutdatedCmd(t *testing.T) {
+	t.Cleanup(func() { pluginIndex = "" })
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.yaml"), []byte(`plugins:
+  - {name: gatewayd-plugin-test, version: v0.1.0, url: a.tar.gz, checksum: a}
+  - {name: gatewayd-plugin-test, version: v0.1.2, url: b.tar.gz, checksum: b}
+  - {name: gatewayd-plugin-test, version: v0.2.0, url: c.tar.gz, checksum: c}
+  - {name: gatewayd-plugin-other, version: v1.0.0, url: d.tar.gz, checksum: d}
+`), FilePermissions))
+	pluginTestConfigFile := filepath.Join(dir, "gatewayd_plugins.yaml")
+	require.NoError(t, os.WriteFile(pluginTestConfigFile, []byte(`plugins:
+  - name: gatewayd-plugin-test
+    url: gatewayd-plugin-test@v0.1.0
+    version: v0.1.0
+  - name: gatewayd-plugin-other
+    url: gatewayd-plugin-other@latest
+    version: v1.0.0
+  - name: gatewayd-plugin-local
+    url: /path/to/plugin.tar.gz
+  - name: gatewayd-plugin-remote
+    url: https://example.com/plugin.tar.gz
+`), FilePermissions))
+
+	output, err := executeCommandC(
+		rootCmd, "plugin", "outdated", "-p", pluginTestConfigFile, "--index", dir)
+	require.NoError(t, err, "plugin outdated should not return an error")
+	assert.Equal(t, `Failed to check the versions of gatewayd-plugin-local: /path/to/plugin.tar.gz is not in the plugin index
+Failed to check the versions of gatewayd-plugin-remote: the releases of https://example.com/plugin.tar.gz can't be checked, since it isn't from GitHub or a plugin index
+NAME                  CURRENT  WANTED  LATEST
+gatewayd-plugin-test  v0.1.0   v0.1.2  v0.2.0
+`, output)
+}
@@ -20,10 +20,13 @@ Usage:
 Available Commands:
   help        Help about any command
   init        Create or overwrite the GatewayD plugins config
-  install     Install a plugin from a local archive or a GitHub repository
+  install     Install a plugin from a local archive, a URL, a plugin index or a GitHub repository
   lint        Lint the GatewayD plugins config
   list        List the GatewayD plugins
+  outdated    List the plugins that have newer releases
   scaffold    Scaffold a plugin and store the files into a directory
+  uninstall   Uninstall the plugins and remove them from the plugins configuration file
+  update      Update the plugins to the latest releases in their version ranges
 
 Flags:
   -h, --help   help for plugin
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"slices"
+
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/gatewayd-io/gatewayd/plugin"
+	"github.com/getsentry/sentry-go"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+var (
+	uninstallBackup bool
+	keepBinary      bool
+)
+
+// pluginUninstallCmd represents the plugin uninstall command.
+var pluginUninstallCmd = &cobra.Command{
+	Use:     "uninstall plugin...",
+	Short:   "Uninstall the plugins and remove them from the plugins configuration file",
+	Example: "  gatewayd plugin uninstall gatewayd-plugin-cache -p gatewayd_plugins.yaml",
+	Args:    cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Enable Sentry.
+		if enableSentry {
+			// Initialize Sentry.
+			err := sentry.Init(sentry.ClientOptions{
+				Dsn:              DSN,
+				TracesSampleRate: config.DefaultTraceSampleRate,
+				AttachStacktrace: config.DefaultAttachStacktrace,
+			})
+			if err != nil {
+				cmd.Println("Sentry initialization failed: ", err)
+				return
+			}
+
+			// Flush buffered events before the program terminates.
+			defer sentry.Flush(config.DefaultFlushTimeout)
+			// Recover from panics and report the error to Sentry.
+			defer sentry.Recover()
+		}
+
+		uninstallPlugins(cmd, args)
+	},
+}
+
+func init() {
+	pluginCmd.AddCommand(pluginUninstallCmd)
+
+	pluginUninstallCmd.Flags().StringVarP(
+		&pluginConfigFile, // Already exists in run.go
+		"plugin-config", "p", config.GetDefaultConfigFilePath(config.PluginsConfigFilename),
+		"Plugin config file")
+	pluginUninstallCmd.Flags().BoolVar(
+		&uninstallBackup, "backup", true, "Backup the plugins configuration file before uninstalling the plugins") //nolint:lll
+	pluginUninstallCmd.Flags().BoolVar(
+		&keepBinary, "keep-binary", false, "Keep the plugin binaries and only remove the plugins from the plugins configuration file") //nolint:lll
+	pluginUninstallCmd.Flags().BoolVar(
+		&enableSentry, "sentry", true, "Enable Sentry") // Already exists in run.go
+}
+
+// uninstallPlugins removes the plugins from the plugins configuration file, and then
+// deletes their binaries and signatures.
+func uninstallPlugins(cmd *cobra.Command, names []string) {
+	// Read the "gatewayd_plugins.yaml" file.
+	pluginsConfig, err := os.ReadFile(pluginConfigFile)
+	if err != nil {
+		cmd.Println(err)
+		return
+	}
+
+	var localPluginsConfig map[string]interface{}
+	if err := yamlv3.Unmarshal(pluginsConfig, &localPluginsConfig); err != nil {
+		cmd.Println("Failed to unmarshal the plugins configuration file: ", err)
+		return
+	}
+
+	// Remove the plugins from the list of plugins.
+	var (
+		pluginsList []interface{}
+		localPaths  []string
+		found       []string
+	)
+	for _, pluginConfig := range cast.ToSlice(localPluginsConfig["plugins"]) {
+		pluginInstance := cast.ToStringMap(pluginConfig)
+		name := cast.ToString(pluginInstance["name"])
+		if !slices.Contains(names, name) {
+			pluginsList = append(pluginsList, pluginConfig)
+			continue
+		}
+
+		found = append(found, name)
+		if localPath := cast.ToString(pluginInstance["localPath"]); localPath != "" {
+			localPaths = append(localPaths, localPath)
+		}
+	}
+
+	for _, name := range names {
+		if !slices.Contains(found, name) {
+			cmd.Println("Plugin not found in the plugins configuration file:", name)
+			return
+		}
+	}
+
+	// Back up the plugins configuration file before it is changed.
+	if uninstallBackup {
+		backupFilename := pluginConfigFile + BackupFileExt
+		if err := os.WriteFile(backupFilename, pluginsConfig, FilePermissions); err != nil {
+			cmd.Println("There was an error backing up the plugins configuration file: ", err)
+			return
+		}
+		cmd.Println("Backup completed successfully")
+	}
+
+	localPluginsConfig["plugins"] = pluginsList
+	if pluginsList == nil {
+		localPluginsConfig["plugins"] = []interface{}{}
+	}
+	updatedPlugins, err := yamlv3.Marshal(localPluginsConfig)
+	if err != nil {
+		cmd.Println("There was an error marshalling the plugins configuration: ", err)
+		return
+	}
+	if err := writeFileAtomically(
+		pluginConfigFile, bytes.NewReader(updatedPlugins), FilePermissions); err != nil {
+		cmd.Println("There was an error writing the plugins configuration file: ", err)
+		return
+	}
+	if err := unlockPlugins(names); err != nil {
+		cmd.Println("There was an error writing the lock file: ", err)
+		return
+	}
+
+	// The binaries are deleted once the plugins are removed from the configuration file,
+	// so that GatewayD doesn't try to load the deleted plugins.
+	if !keepBinary {
+		for _, localPath := range localPaths {
+			for _, filename := range append([]string{localPath}, signatureFiles(localPath)...) {
+				if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+					cmd.Println("There was an error deleting the file: ", err)
+				}
+			}
+		}
+	}
+
+	for _, name := range names {
+		cmd.Println("Plugin", name, "uninstalled successfully")
+	}
+}
+
+// signatureFiles returns the paths of the detached signatures of the plugin binary.
+func signatureFiles(localPath string) []string {
+	files := make([]string, 0, len(plugin.SignatureExtensions))
+	for _, ext := range plugin.SignatureExtensions {
+		files = append(files, localPath+ext)
+	}
+	return files
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_pluginUninstallCmd(t *testing.T) {
+	dir := t.TempDir()
+	pluginTestConfigFile := filepath.Join(dir, "gatewayd_plugins.yaml")
+	binary := filepath.Join(dir, "gatewayd-plugin-test")
+	require.NoError(t, os.WriteFile(binary, []byte("binary"), ExecFilePermissions))
+	require.NoError(t, os.WriteFile(binary+".minisig", []byte("signature"), FilePermissions))
+	require.NoError(t, os.WriteFile(pluginTestConfigFile, []byte(`plugins:
+  - name: gatewayd-plugin-test
+    localPath: `+binary+`
+  - name: gatewayd-plugin-other
+    localPath: other
+`), FilePermissions))
+
+	// The unknown plugins are not uninstalled.
+	output, err := executeCommandC(
+		rootCmd, "plugin", "uninstall", "-p", pluginTestConfigFile, "gatewayd-plugin-unknown")
+	require.NoError(t, err, "plugin uninstall should not return an error")
+	assert.Equal(t,
+		"Plugin not found in the plugins configuration file: gatewayd-plugin-unknown\n", output)
+	assert.NoFileExists(t, pluginTestConfigFile+BackupFileExt)
+
+	output, err = executeCommandC(
+		rootCmd, "plugin", "uninstall", "-p", pluginTestConfigFile, "gatewayd-plugin-test")
+	require.NoError(t, err, "plugin uninstall should not return an error")
+	assert.Equal(t, "Backup completed successfully\nPlugin gatewayd-plugin-test uninstalled successfully\n", output) //nolint:lll
+
+	// The binary and its signature are deleted, and the config is backed up.
+	assert.NoFileExists(t, binary)
+	assert.NoFileExists(t, binary+".minisig")
+	assert.FileExists(t, pluginTestConfigFile+BackupFileExt)
+
+	pluginsConfig, err := os.ReadFile(pluginTestConfigFile)
+	require.NoError(t, err)
+	assert.NotContains(t, string(pluginsConfig), "gatewayd-plugin-test")
+	assert.Contains(t, string(pluginsConfig), "gatewayd-plugin-other")
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"path/filepath"
+	"slices"
+
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/getsentry/sentry-go"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var updateBackup bool
+
+// pluginUpdateCmd represents the plugin update command.
+var pluginUpdateCmd = &cobra.Command{
+	Use:     "update [plugin...]",
+	Short:   "Update the plugins to the latest releases in their version ranges",
+	Example: "  gatewayd plugin update [gatewayd-plugin-cache] -p gatewayd_plugins.yaml [--index <https://mirror/index.yaml|/path/to/mirror>]", //nolint:lll
+	Run: func(cmd *cobra.Command, args []string) {
+		// Enable Sentry.
+		if enableSentry {
+			// Initialize Sentry.
+			err := sentry.Init(sentry.ClientOptions{
+				Dsn:              DSN,
+				TracesSampleRate: config.DefaultTraceSampleRate,
+				AttachStacktrace: config.DefaultAttachStacktrace,
+			})
+			if err != nil {
+				cmd.Println("Sentry initialization failed: ", err)
+				return
+			}
+
+			// Flush buffered events before the program terminates.
+			defer sentry.Flush(config.DefaultFlushTimeout)
+			// Recover from panics and report the error to Sentry.
+			defer sentry.Recover()
+		}
+
+		plugins, err := readPluginsList()
+		if err != nil {
+			cmd.Println(err)
+			return
+		}
+
+		for _, name := range args {
+			if !slices.ContainsFunc(plugins, func(plugin map[string]interface{}) bool {
+				return plugin["name"] == name
+			}) {
+				cmd.Println("Plugin not found in the plugins configuration file:", name)
+				return
+			}
+		}
+
+		for _, plugin := range plugins {
+			name := cast.ToString(plugin["name"])
+			if len(args) > 0 && !slices.Contains(args, name) {
+				continue
+			}
+
+			versions, err := checkPluginVersions(plugin)
+			if err != nil {
+				cmd.Printf("Failed to check the versions of %s: %s\n", name, err)
+				continue
+			}
+			if !versions.updatable() {
+				cmd.Println(name, "is up to date")
+				continue
+			}
+
+			cmd.Printf("Updating %s from %s to %s\n",
+				name, versionString(versions.current), releaseString(versions.wanted))
+
+			// The plugin is installed in place of the installed binary, and its settings
+			// are kept, except for its checksum and version.
+			update, noPrompt, overwriteConfig, pullOnly, frozen = true, true, true, false, false
+			backupConfig = updateBackup
+			pluginName = name
+			if localPath := cast.ToString(plugin["localPath"]); localPath != "" {
+				pluginOutputDir = filepath.Dir(localPath)
+			}
+			installPlugin(cmd, versions.wanted.installURL)
+		}
+	},
+}
+
+func init() {
+	pluginCmd.AddCommand(pluginUpdateCmd)
+
+	pluginUpdateCmd.Flags().StringVarP(
+		&pluginConfigFile, // Already exists in run.go
+		"plugin-config", "p", config.GetDefaultConfigFilePath(config.PluginsConfigFilename),
+		"Plugin config file")
+	pluginUpdateCmd.Flags().StringVarP(
+		&globalConfigFile, // Already exists in run.go
+		"config", "c", config.GetDefaultConfigFilePath(config.GlobalConfigFilename),
+		"Global config file, which has the signature policy and the trusted keys")
+	pluginUpdateCmd.Flags().StringVar(
+		&pluginIndex, "index", "", "URL, file or directory of a plugin index to update the plugins from") //nolint:lll
+	pluginUpdateCmd.Flags().BoolVar(
+		&updateBackup, "backup", true, "Backup the plugins configuration file before updating the plugins") //nolint:lll
+	pluginUpdateCmd.Flags().BoolVar(
+		&skipPathSlipVerification, "skip-path-slip-verification", false, "Skip path slip verification when extracting the plugin archive from a TRUSTED source") //nolint:lll
+	pluginUpdateCmd.Flags().BoolVar(
+		&enableSentry, "sentry", true, "Enable Sentry") // Already exists in run.go
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestArchive writes a plugin archive with the binary, its signature if it is given,
+// and the default plugin config, and returns its checksum.
+func writeTestArchive(t *testing.T, filename, binary string, signature ...string) string {
+	t.Helper()
+
+	type archiveEntry struct{ name, contents string }
+	entries := []archiveEntry{{"gatewayd-plugin-test", binary}}
+	for _, sig := range signature {
+		entries = append(entries, archiveEntry{"gatewayd-plugin-test.sig", sig})
+	}
+	entries = append(entries, archiveEntry{
+		"gatewayd_plugin.yaml", "plugins:\n  - name: gatewayd-plugin-test\n    enabled: true\n",
+	})
+
+	file, err := os.Create(filename)
+	require.NoError(t, err)
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+	// The files are in order, so that the same archive has the same checksum.
+	for _, entry := range entries {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{
+			Name: entry.name, Mode: int64(ExecFilePermissions), Size: int64(len(entry.contents)),
+		}))
+		_, err := tarWriter.Write([]byte(entry.contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	require.NoError(t, file.Close())
+
+	contents, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	sum := sha256.Sum256(contents)
+	return hex.EncodeToString(sum[:])
+}
+
+func Test_pluginUpdateCmd(t *testing.T) {
+	if getFileExtension() != ExtensionTarGz {
+		t.Skip("The test archives are tar.gz archives")
+	}
+	t.Cleanup(func() {
+		pluginIndex = ""
+		pluginName = ""
+		pluginOutputDir = "./plugins"
+		backupConfig = false
+		update = false
+	})
+
+	dir := t.TempDir()
+	index := "plugins:\n"
+	for _, version := range []string{"v0.1.0", "v0.1.2", "v0.2.0"} {
+		filename := fmt.Sprintf("gatewayd-plugin-test-%s-%s-%s.tar.gz",
+			runtime.GOOS, runtime.GOARCH, version)
+		sum := writeTestArchive(t, filepath.Join(dir, filename), version)
+		index += fmt.Sprintf("  - {name: gatewayd-plugin-test, version: %s, url: %s, checksum: %s}\n",
+			version, filename, sum)
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.yaml"), []byte(index), FilePermissions))
+
+	binary := filepath.Join(dir, "plugins", "gatewayd-plugin-test")
+	require.NoError(t, os.MkdirAll(filepath.Dir(binary), FolderPermissions))
+	require.NoError(t, os.WriteFile(binary, []byte("v0.1.0"), ExecFilePermissions))
+	pluginTestConfigFile := filepath.Join(dir, "gatewayd_plugins.yaml")
+	require.NoError(t, os.WriteFile(pluginTestConfigFile, []byte(`plugins:
+  - name: gatewayd-plugin-test
+    enabled: false
+    url: gatewayd-plugin-test@v0.1.0
+    version: v0.1.0
+    localPath: `+binary+`
+    checksum: old
+`), FilePermissions))
+
+	output, err := executeCommandC(
+		rootCmd, "plugin", "update", "-p", pluginTestConfigFile, "--index", dir)
+	require.NoError(t, err, "plugin update should not return an error")
+	assert.Contains(t, output, "Updating gatewayd-plugin-test from v0.1.0 to v0.1.2")
+	assert.Contains(t, output, "Checksum verification passed")
+	assert.Contains(t, output, "Plugin installed successfully")
+	assert.FileExists(t, pluginTestConfigFile+BackupFileExt)
+
+	// The binary is replaced by the wanted version, and the settings are kept.
+	contents, err := os.ReadFile(binary)
+	require.NoError(t, err)
+	assert.Equal(t, "v0.1.2", string(contents))
+	sum := sha256.Sum256(contents)
+
+	pluginsConfig, err := os.ReadFile(pluginTestConfigFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(pluginsConfig), "enabled: false")
+	assert.Contains(t, string(pluginsConfig), "url: gatewayd-plugin-test@v0.1.0")
+	assert.Contains(t, string(pluginsConfig), "version: v0.1.2")
+	assert.Contains(t, string(pluginsConfig), "checksum: "+hex.EncodeToString(sum[:]))
+
+	// The plugin is up to date in its version range.
+	output, err = executeCommandC(
+		rootCmd, "plugin", "update", "-p", pluginTestConfigFile, "--index", dir)
+	require.NoError(t, err, "plugin update should not return an error")
+	assert.Equal(t, "gatewayd-plugin-test is up to date\n", output)
+
+	// The unknown plugins are not updated.
+	output, err = executeCommandC(
+		rootCmd, "plugin", "update", "-p", pluginTestConfigFile, "--index", dir, "unknown")
+	require.NoError(t, err, "plugin update should not return an error")
+	assert.Equal(t, "Plugin not found in the plugins configuration file: unknown\n", output)
+}
@@ -17,6 +17,7 @@ Usage:
 Available Commands:
   completion  Generate the autocompletion script for the specified shell
   config      Manage GatewayD global configuration
+  ctl         Inspect a running GatewayD instance through its gRPC API
   help        Help about any command
   plugin      Manage plugins and their configuration
   run         Run a GatewayD instance
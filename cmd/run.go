@@ -24,6 +24,7 @@ import (
 	"github.com/gatewayd-io/gatewayd/api"
 	"github.com/gatewayd-io/gatewayd/config"
 	gerr "github.com/gatewayd-io/gatewayd/errors"
+	"github.com/gatewayd-io/gatewayd/events"
 	"github.com/gatewayd-io/gatewayd/logging"
 	"github.com/gatewayd-io/gatewayd/metrics"
 	"github.com/gatewayd-io/gatewayd/network"
@@ -70,6 +71,7 @@ var (
 	conf              *config.Config
 	pluginRegistry    *plugin.Registry
 	actRegistry       *act.Registry
+	eventBus          *events.Bus
 	metricsServer     *http.Server
 
 	UsageReportURL = "localhost:59091"
@@ -157,6 +159,9 @@ func StopGracefully(
 	}
 	span.End()
 
+	// Close the event streams, so that the API servers don't wait for them.
+	eventBus.Close()
+
 	if httpServer != nil {
 		httpServer.Shutdown(runCtx)
 		logger.Info().Msg("Stopped HTTP Server")
@@ -284,6 +289,13 @@ var runCmd = &cobra.Command{
 				"Running GatewayD in development mode (not recommended for production)")
 		}
 
+		// Create the event bus, which the components publish their events to,
+		// and the API streams them to its subscribers.
+		eventBus = events.NewBus(events.Bus{
+			Logger:     logger,
+			BufferSize: config.DefaultEventBufferSize,
+		})
+
 		// Create a new act registry given the built-in signals, policies, and actions.
 		actRegistry = act.NewActRegistry(
 			act.Registry{
@@ -294,6 +306,7 @@ var runCmd = &cobra.Command{
 				PolicyTimeout:        conf.Plugin.PolicyTimeout,
 				DefaultActionTimeout: conf.Plugin.ActionTimeout,
 				Logger:               logger,
+				EventBus:             eventBus,
 			})
 
 		if actRegistry == nil {
@@ -316,6 +329,26 @@ var runCmd = &cobra.Command{
 			"policies": maps.Keys(actRegistry.Policies),
 		}).Msg("Policies are loaded")
 
+		// The hooks whose results are ignored can be run in the background.
+		asyncHooks, err := plugin.ParseAsyncHooks(conf.Plugin.AsyncHooks)
+		if err != nil {
+			logger.Error().Err(err).Msg("Invalid async hooks, so all the hooks are run in order")
+			asyncHooks = nil
+		}
+
+		// The signature policy and the trusted keys are in the global config, so that the
+		// plugins config can't weaken them. An unknown policy isn't replaced by the default.
+		signaturePolicy, policyErr := conf.Global.PluginSignatures.GetPolicy()
+		if policyErr != nil {
+			logger.Error().Err(policyErr).Msg("Invalid signature policy of the plugins")
+			os.Exit(gerr.FailedToCreatePluginRegistry)
+		}
+		// The plugins can't be verified with the trusted keys that are invalid.
+		trustedKeys, keysErr := plugin.ParseTrustedKeys(conf.Global.PluginSignatures.TrustedKeys)
+		if keysErr != nil {
+			logger.Error().Err(keysErr).Msg("Invalid trusted keys of the plugin signatures")
+		}
+
 		// Create a new plugin registry.
 		// The plugins are loaded and hooks registered before the configuration is loaded.
 		pluginRegistry = plugin.NewRegistry(
@@ -328,14 +361,34 @@ var runCmd = &cobra.Command{
 					),
 					config.CompatibilityPolicies[conf.Plugin.CompatibilityPolicy],
 					config.DefaultCompatibilityPolicy),
-				Logger:  logger,
-				DevMode: devMode,
+				Logger:          logger,
+				DevMode:         devMode,
+				EventBus:        eventBus,
+				BuiltinPlugins:  plugin.BuiltinPlugins(),
+				SignaturePolicy: signaturePolicy,
+				TrustedKeys:     trustedKeys,
+				AsyncHooks:      asyncHooks,
+				AsyncWorkers:    conf.Plugin.AsyncWorkers,
+				AsyncQueueSize:  conf.Plugin.AsyncQueueSize,
+				PluginTimeout:   conf.Plugin.Timeout,
 			},
 		)
 
-		// Load plugins and register their hooks.
+		// The plugins whose priorities are taken by other plugins aren't loaded.
+		if err := plugin.ValidatePriorities(
+			conf.Plugin.Plugins, conf.Plugin.ExpressionHooks); err != nil {
+			logger.Error().Err(err).Msg("The priorities of the plugins conflict")
+		}
+
+		// Load plugins in the order of their requirements and register their hooks.
+		// The plugins whose requirements are not met are reported by the registry.
 		pluginRegistry.LoadPlugins(runCtx, conf.Plugin.Plugins, conf.Plugin.StartTimeout)
 
+		// Register the hooks that are defined by expressions in the config.
+		if err := pluginRegistry.LoadExpressionHooks(conf.Plugin.ExpressionHooks); err != nil {
+			logger.Error().Err(err).Msg("Failed to load some of the expression hooks")
+		}
+
 		// Start the metrics merger if enabled.
 		var metricsMerger *metrics.Merger
 		if conf.Plugin.EnableMetricsMerger {
@@ -364,10 +417,15 @@ var runCmd = &cobra.Command{
 			defer span.End()
 
 			var plugins []string
-			pluginRegistry.ForEach(func(pluginId sdkPlugin.Identifier, plugin *plugin.Plugin) {
-				if err := plugin.Ping(); err != nil {
+			pluginRegistry.ForEach(func(pluginId sdkPlugin.Identifier, pluginImpl *plugin.Plugin) {
+				if err := pluginImpl.Ping(); err != nil {
 					span.RecordError(err)
 					logger.Error().Err(err).Msg("Failed to ping plugin")
+					eventBus.Publish(events.PluginCrashed, "plugin", map[string]interface{}{
+						"name":    pluginId.Name,
+						"version": pluginId.Version,
+						"error":   err.Error(),
+					})
 					if conf.Plugin.EnableMetricsMerger && metricsMerger != nil {
 						metricsMerger.Remove(pluginId.Name)
 					}
@@ -379,9 +437,20 @@ var runCmd = &cobra.Command{
 
 					// Reload the plugins and register their hooks upon crash.
 					logger.Info().Str("name", pluginId.Name).Msg("Reloading crashed plugin")
-					pluginConfig := conf.Plugin.GetPlugins(pluginId.Name)
-					if pluginConfig != nil {
-						pluginRegistry.LoadPlugins(runCtx, pluginConfig, conf.Plugin.StartTimeout)
+					pluginConfig, priority, found := plugin.FindPluginConfig(
+						conf.Plugin.Plugins, pluginId.Name)
+					if found {
+						if err := pluginRegistry.LoadPlugin(
+							runCtx, pluginConfig, priority, conf.Plugin.StartTimeout,
+						); err != nil {
+							logger.Error().Err(err).Str("name", pluginId.Name).Msg(
+								"Failed to reload crashed plugin")
+						} else {
+							eventBus.Publish(events.PluginReloaded, "plugin", map[string]interface{}{
+								"name":    pluginId.Name,
+								"version": pluginId.Version,
+							})
+						}
 					}
 				} else {
 					logger.Trace().Str("name", pluginId.Name).Msg("Successfully pinged plugin")
@@ -738,6 +807,11 @@ var runCmd = &cobra.Command{
 					if err != nil {
 						logger.Error().Err(err).Msg("Failed to run OnNewClient hooks")
 						span.RecordError(err)
+						if errors.Is(err, gerr.ErrPluginFailedClosed) {
+							// The connection isn't added to the pool.
+							client.Close()
+							continue
+						}
 					}
 
 					err = pools[name].Put(client.ID, client)
@@ -818,15 +892,18 @@ var runCmd = &cobra.Command{
 					AvailableConnections: pools[name],
 					PluginRegistry:       pluginRegistry,
 					HealthCheckPeriod:    cfg.HealthCheckPeriod,
+					SkipCopyHooks:        cfg.SkipCopyHooks,
 					ClientConfig:         clientConfig,
 					Logger:               logger,
 					PluginTimeout:        conf.Plugin.Timeout,
+					EventBus:             eventBus,
 				},
 			)
 
 			span.AddEvent("Create proxy", trace.WithAttributes(
 				attribute.String("name", name),
 				attribute.String("healthCheckPeriod", cfg.HealthCheckPeriod.String()),
+				attribute.Bool("skipCopyHooks", cfg.SkipCopyHooks),
 			))
 
 			pluginTimeoutCtx, cancel = context.WithTimeout(
@@ -873,6 +950,12 @@ var runCmd = &cobra.Command{
 					CertFile:         cfg.CertFile,
 					KeyFile:          cfg.KeyFile,
 					HandshakeTimeout: cfg.HandshakeTimeout,
+					// Session timeouts.
+					IdleTimeout:              cfg.IdleTimeout,
+					IdleInTransactionTimeout: cfg.IdleInTransactionTimeout,
+					MaxSessionDuration:       cfg.MaxSessionDuration,
+					AdminDatabase:            cfg.AdminDatabase,
+					EventBus:                 eventBus,
 				},
 			)
 
@@ -886,6 +969,10 @@ var runCmd = &cobra.Command{
 				attribute.String("certFile", cfg.CertFile),
 				attribute.String("keyFile", cfg.KeyFile),
 				attribute.String("handshakeTimeout", cfg.HandshakeTimeout.String()),
+				attribute.String("idleTimeout", cfg.IdleTimeout.String()),
+				attribute.String("idleInTransactionTimeout", cfg.IdleInTransactionTimeout.String()),
+				attribute.String("maxSessionDuration", cfg.MaxSessionDuration.String()),
+				attribute.String("adminDatabase", cfg.AdminDatabase),
 			))
 
 			pluginTimeoutCtx, cancel = context.WithTimeout(
@@ -906,39 +993,75 @@ var runCmd = &cobra.Command{
 
 		span.End()
 
-		// Start the HTTP and gRPC APIs.
-		if conf.Global.API.Enabled {
-			apiOptions := api.Options{
-				Logger:      logger,
-				GRPCNetwork: conf.Global.API.GRPCNetwork,
-				GRPCAddress: conf.Global.API.GRPCAddress,
-				HTTPAddress: conf.Global.API.HTTPAddress,
-				Servers:     servers,
+		requiredPlugins := []string{}
+		for _, pluginConfig := range conf.Plugin.Plugins {
+			if pluginConfig.Enabled && pluginConfig.Required {
+				requiredPlugins = append(requiredPlugins, pluginConfig.Name)
 			}
+		}
+		readiness := &api.ReadinessChecker{
+			Servers:         servers,
+			PluginRegistry:  pluginRegistry,
+			RequiredPlugins: requiredPlugins,
+			PoolThreshold:   conf.Global.API.Readiness.PoolThreshold,
+			DialTimeout:     conf.Global.API.Readiness.DialTimeout,
+		}
 
-			apiObj := &api.API{
-				Options:        &apiOptions,
-				Config:         conf,
-				PluginRegistry: pluginRegistry,
-				Pools:          pools,
-				Proxies:        proxies,
-				Servers:        servers,
-			}
+		apiOptions := api.Options{
+			Logger:      logger,
+			GRPCNetwork: conf.Global.API.GRPCNetwork,
+			GRPCAddress: conf.Global.API.GRPCAddress,
+			HTTPAddress: conf.Global.API.HTTPAddress,
+			Servers:     servers,
+			// TLS and authentication.
+			EnableTLS:    conf.Global.API.EnableTLS,
+			CertFile:     conf.Global.API.CertFile,
+			KeyFile:      conf.Global.API.KeyFile,
+			ClientCAFile: conf.Global.API.ClientCAFile,
+			Auth:         conf.Global.API.Auth,
+			EventBus:     eventBus,
+			Readiness:    readiness,
+		}
+
+		apiObj := &api.API{
+			Options:        &apiOptions,
+			Config:         conf,
+			PluginRegistry: pluginRegistry,
+			Pools:          pools,
+			Proxies:        proxies,
+			Servers:        servers,
+		}
+
+		// The admin console uses the API, even if the HTTP and gRPC APIs are disabled.
+		for _, server := range servers {
+			server.AdminConsole = &api.AdminConsole{API: apiObj}
+		}
+
+		// Start the HTTP and gRPC APIs.
+		if conf.Global.API.Enabled {
 			grpcServer = api.NewGRPCServer(api.GRPCServer{
-				API:           apiObj,
-				HealthChecker: &api.HealthChecker{Servers: servers},
+				API: apiObj,
+				HealthChecker: &api.HealthChecker{
+					Servers:   servers,
+					Readiness: readiness,
+					EventBus:  eventBus,
+				},
 			})
 			if grpcServer != nil {
 				go grpcServer.Start()
-				logger.Info().Str("address", apiOptions.HTTPAddress).Msg("Started the HTTP API")
 
 				httpServer = api.NewHTTPServer(&apiOptions)
-				go httpServer.Start()
+				if httpServer != nil {
+					go httpServer.Start()
+					logger.Info().Str("address", apiOptions.HTTPAddress).Msg("Started the HTTP API")
+				}
 
 				logger.Info().Fields(
 					map[string]interface{}{
-						"network": apiOptions.GRPCNetwork,
-						"address": apiOptions.GRPCAddress,
+						"network":    apiOptions.GRPCNetwork,
+						"address":    apiOptions.GRPCAddress,
+						"tls":        apiOptions.EnableTLS,
+						"authMethod": apiOptions.Auth.Method,
 					},
 				).Msg("Started the gRPC Server")
 			}
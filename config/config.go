@@ -174,6 +174,17 @@ func (c *Config) LoadDefaults(ctx context.Context) *gerr.GatewayDError {
 			HTTPAddress: DefaultHTTPAPIAddress,
 			GRPCNetwork: DefaultGRPCAPINetwork,
 			GRPCAddress: DefaultGRPCAPIAddress,
+			Auth: APIAuth{
+				Method: DefaultAPIAuthMethod,
+			},
+			Readiness: Readiness{
+				PoolThreshold: DefaultReadinessPoolThreshold,
+				DialTimeout:   DefaultReadinessDialTimeout,
+			},
+		},
+		PluginSignatures: PluginSignatures{
+			Policy:      string(DefaultSignaturePolicy),
+			TrustedKeys: []string{},
 		},
 	}
 
@@ -207,7 +218,7 @@ func (c *Config) LoadDefaults(ctx context.Context) *gerr.GatewayDError {
 						c.globalDefaults.Proxies[configGroupKey] = &defaultProxy
 					case "servers":
 						c.globalDefaults.Servers[configGroupKey] = &defaultServer
-					case "api":
+					case "api", "pluginSignatures":
 						// TODO: Add support for multiple API config groups.
 					default:
 						err := fmt.Errorf("unknown config object: %s", configObject)
@@ -237,6 +248,10 @@ func (c *Config) LoadDefaults(ctx context.Context) *gerr.GatewayDError {
 		PolicyTimeout:       DefaultPolicyTimeout,
 		ActionTimeout:       DefaultActionTimeout,
 		Policies:            []Policy{},
+		AsyncHooks:          []string{},
+		AsyncWorkers:        DefaultAsyncHookWorkers,
+		AsyncQueueSize:      DefaultAsyncHookQueueSize,
+		ExpressionHooks:     []ExpressionHook{},
 	}
 
 	if c.GlobalKoanf != nil {
@@ -484,6 +499,13 @@ func (c *Config) ValidateGlobalConfig(ctx context.Context) *gerr.GatewayDError {
 		seenConfigObjects = append(seenConfigObjects, "servers")
 	}
 
+	errors = append(errors, validateAPI(globalConfig.API)...)
+
+	if _, err := globalConfig.PluginSignatures.GetPolicy(); err != nil {
+		span.RecordError(err)
+		errors = append(errors, gerr.ErrValidationFailed.Wrap(err))
+	}
+
 	sort.Strings(seenConfigObjects)
 
 	if len(seenConfigObjects) > 0 && !reflect.DeepEqual(configObjects, seenConfigObjects) {
@@ -519,3 +541,48 @@ func (c *Config) ValidateGlobalConfig(ctx context.Context) *gerr.GatewayDError {
 
 	return nil
 }
+
+// validateAPI validates the TLS, the authentication and the readiness settings of the API.
+func validateAPI(api API) []*gerr.GatewayDError {
+	var errors []*gerr.GatewayDError
+
+	if api.EnableTLS && (api.CertFile == "" || api.KeyFile == "") {
+		errors = append(errors, gerr.ErrValidationFailed.Wrap(
+			goerrors.New("\"api.certFile\" and \"api.keyFile\" are required when TLS is enabled")))
+	}
+	if api.ClientCAFile != "" && !api.EnableTLS {
+		errors = append(errors, gerr.ErrValidationFailed.Wrap(
+			goerrors.New("\"api.clientCAFile\" requires TLS to be enabled")))
+	}
+
+	method := api.Auth.Method
+	if method == "" {
+		method = AuthMethodNone
+	}
+	if !slices.Contains(APIAuthMethods, method) {
+		errors = append(errors, gerr.ErrValidationFailed.Wrap(
+			fmt.Errorf("\"api.auth.method\" is invalid: %q", api.Auth.Method)))
+	} else if method != AuthMethodNone && len(api.Auth.Credentials) == 0 {
+		errors = append(errors, gerr.ErrValidationFailed.Wrap(
+			fmt.Errorf("\"api.auth.credentials\" are required by the %q method", method)))
+	}
+
+	if api.Readiness.PoolThreshold < 0 || api.Readiness.PoolThreshold > 1 {
+		errors = append(errors, gerr.ErrValidationFailed.Wrap(
+			fmt.Errorf("\"api.readiness.poolThreshold\" must be between 0 and 1: %v",
+				api.Readiness.PoolThreshold)))
+	}
+
+	for index, credential := range api.Auth.Credentials {
+		if credential.Token == "" {
+			errors = append(errors, gerr.ErrValidationFailed.Wrap(
+				fmt.Errorf("\"api.auth.credentials[%d].token\" is empty", index)))
+		}
+		if _, ok := APIRoles[credential.Role]; !ok {
+			errors = append(errors, gerr.ErrValidationFailed.Wrap(
+				fmt.Errorf("\"api.auth.credentials[%d].role\" is invalid: %q", index, credential.Role)))
+		}
+	}
+
+	return errors
+}
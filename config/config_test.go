@@ -57,6 +57,8 @@ func TestInitConfig(t *testing.T) {
 	assert.NotNil(t, config.pluginDefaults)
 	assert.NotEqual(t, PluginConfig{}, config.pluginDefaults)
 	assert.Empty(t, config.pluginDefaults.Plugins)
+	// The hooks are only run in the background if they are configured to.
+	assert.Empty(t, config.pluginDefaults.AsyncHooks)
 }
 
 // TestInitConfigMultiTenant tests the InitConfig function with a multi-tenant configuration.
@@ -142,3 +144,27 @@ func TestMergeGlobalConfig(t *testing.T) {
 	// The log level should now be debug.
 	assert.Equal(t, "debug", config.Global.Loggers[Default].Level)
 }
+
+// TestValidateAPI tests the validation of the TLS, the authentication and the readiness settings of the API.
+func TestValidateAPI(t *testing.T) {
+	assert.Empty(t, validateAPI(API{Auth: APIAuth{Method: AuthMethodNone}}))
+	assert.Empty(t, validateAPI(API{
+		EnableTLS: true,
+		CertFile:  "api.crt",
+		KeyFile:   "api.key",
+		Auth: APIAuth{
+			Method:      AuthMethodBearer,
+			Credentials: []APICredential{{Name: "admin", Token: "secret", Role: "admin"}},
+		},
+	}))
+
+	assert.Len(t, validateAPI(API{EnableTLS: true, ClientCAFile: "ca.crt"}), 1)
+	assert.Len(t, validateAPI(API{ClientCAFile: "ca.crt"}), 1)
+	assert.Len(t, validateAPI(API{Auth: APIAuth{Method: "basic"}}), 1)
+	assert.Len(t, validateAPI(API{Auth: APIAuth{Method: AuthMethodAPIKey}}), 1)
+	assert.Len(t, validateAPI(API{Auth: APIAuth{
+		Method:      AuthMethodAPIKey,
+		Credentials: []APICredential{{Name: "admin", Role: "root"}},
+	}}), 2)
+	assert.Len(t, validateAPI(API{Readiness: Readiness{PoolThreshold: 1.5}}), 1)
+}
@@ -7,7 +7,10 @@ import (
 type (
 	Status              uint
 	CompatibilityPolicy string
+	SignaturePolicy     string
+	FailureMode         string
 	LogOutput           uint
+	APIRole             uint
 )
 
 // Status is the status of the server.
@@ -22,6 +25,19 @@ const (
 	Loose  CompatibilityPolicy = "loose"  // Load the plugin, even if the requirements are not met
 )
 
+// SignaturePolicy decides whether the plugins must be signed by a trusted key.
+const (
+	SignaturesDisabled SignaturePolicy = "disabled" // Don't verify the signatures of the plugins
+	SignaturesOptional SignaturePolicy = "optional" // Verify the signatures of the plugins that are signed
+	SignaturesRequired SignaturePolicy = "required" // Refuse the plugins that aren't signed by a trusted key
+)
+
+// FailureMode is what happens to the traffic when a plugin fails.
+const (
+	FailOpen   FailureMode = "open"   // Skip the plugin and pass the traffic on
+	FailClosed FailureMode = "closed" // Terminate the traffic
+)
+
 // LogOutput is the output type for the logger.
 const (
 	Console LogOutput = iota
@@ -32,6 +48,20 @@ const (
 	RSyslog
 )
 
+// APIRole is the role of an API credential. The roles are ordered,
+// so that a role is allowed to call the methods of the lower roles.
+const (
+	RoleReadOnly APIRole = iota // Call the methods that read the state
+	RoleAdmin                   // Call all the methods, including the ones that change the state
+)
+
+// These are the authentication methods of the API.
+const (
+	AuthMethodNone   = "none"   // No authentication
+	AuthMethodBearer = "bearer" // The token is sent in the "Authorization: Bearer <token>" header
+	AuthMethodAPIKey = "apiKey" // The token is sent in the "X-API-Key" header
+)
+
 const (
 	// Config constants.
 	Default               = "default"
@@ -66,6 +96,11 @@ const (
 	DefaultPluginHealthCheckPeriod = 5 * time.Second
 	DefaultPluginTimeout           = 30 * time.Second
 	DefaultPluginStartTimeout      = 1 * time.Minute
+	DefaultAsyncHookWorkers        = 4
+	DefaultAsyncHookQueueSize      = 1024
+	DefaultFailureMode             = FailOpen
+	DefaultBreakerFailureThreshold = 5
+	DefaultBreakerCoolDown         = 30 * time.Second
 
 	// Client constants.
 	DefaultNetwork            = "tcp"
@@ -112,9 +147,18 @@ const (
 	DefaultHTTPAPIAddress = "localhost:18080"
 	DefaultGRPCAPINetwork = "tcp"
 	DefaultGRPCAPIAddress = "localhost:19090"
+	DefaultAPIAuthMethod  = AuthMethodNone
+	APIKeyHeader          = "X-API-Key"
+	RedactedSecret        = "********"
+	// The events that a subscriber of the event stream can't receive in time are dropped.
+	DefaultEventBufferSize = 128
+	// A pool is exhausted for the readiness check when all of its connections are busy.
+	DefaultReadinessPoolThreshold = 1.0
+	DefaultReadinessDialTimeout   = 1 * time.Second
 
 	// Policies.
 	DefaultCompatibilityPolicy = Strict
+	DefaultSignaturePolicy     = SignaturesOptional
 
 	// Act.
 	DefaultPolicy        = "passthrough"
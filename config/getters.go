@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"time"
@@ -13,6 +14,11 @@ var (
 		"strict": Strict,
 		"loose":  Loose,
 	}
+	SignaturePolicies = map[string]SignaturePolicy{
+		"disabled": SignaturesDisabled,
+		"optional": SignaturesOptional,
+		"required": SignaturesRequired,
+	}
 	logOutputs = map[string]LogOutput{
 		"console": Console,
 		"stdout":  Stdout,
@@ -46,7 +52,12 @@ var (
 		"StampMicro":  time.StampMicro,
 		"StampNano":   time.StampNano,
 	}
-	LogLevels = map[string]zerolog.Level{
+	APIRoles = map[string]APIRole{
+		"readonly": RoleReadOnly,
+		"admin":    RoleAdmin,
+	}
+	APIAuthMethods = []string{AuthMethodNone, AuthMethodBearer, AuthMethodAPIKey}
+	LogLevels      = map[string]zerolog.Level{
 		"trace":    zerolog.TraceLevel,
 		"debug":    zerolog.DebugLevel,
 		"info":     zerolog.InfoLevel,
@@ -107,6 +118,37 @@ func GetDefaultConfigFilePath(filename string) string {
 	return filepath.Join("./", filename)
 }
 
+// GetRole returns the role of the API credential, which defaults to read-only.
+func (c APICredential) GetRole() APIRole {
+	if role, ok := APIRoles[c.Role]; ok {
+		return role
+	}
+	return RoleReadOnly
+}
+
+// Redacted returns a copy of the API config whose credentials have no tokens,
+// so that the config can be shared.
+func (a API) Redacted() API {
+	credentials := make([]APICredential, 0, len(a.Auth.Credentials))
+	for _, credential := range a.Auth.Credentials {
+		credential.Token = RedactedSecret
+		credentials = append(credentials, credential)
+	}
+	a.Auth.Credentials = credentials
+	return a
+}
+
+// GetPolicy returns the signature policy of the plugins. An unknown policy is an error,
+// instead of the default policy, so that a misspelt "required" doesn't weaken it.
+func (s PluginSignatures) GetPolicy() (SignaturePolicy, error) {
+	policy, ok := SignaturePolicies[s.Policy]
+	if !ok {
+		return "", fmt.Errorf( //nolint:goerr113
+			"\"pluginSignatures.policy\" is invalid: %q", s.Policy)
+	}
+	return policy, nil
+}
+
 // Filter returns a filtered global config based on the group name.
 func (gc GlobalConfig) Filter(groupName string) *GlobalConfig {
 	if _, ok := gc.Servers[groupName]; !ok {
@@ -119,6 +161,8 @@ func (gc GlobalConfig) Filter(groupName string) *GlobalConfig {
 		Proxies: map[string]*Proxy{groupName: gc.Proxies[groupName]},
 		Servers: map[string]*Server{groupName: gc.Servers[groupName]},
 		Metrics: map[string]*Metrics{groupName: gc.Metrics[groupName]},
-		API:     gc.API,
+		API:     gc.API.Redacted(),
+		// The trusted keys are public keys.
+		PluginSignatures: gc.PluginSignatures,
 	}
 }
@@ -27,6 +27,16 @@ func TestGetPlugins(t *testing.T) {
 	assert.Equal(t, []Plugin{plugin}, pluginConfig.GetPlugins("plugin1"))
 }
 
+// TestGetPolicy tests that an unknown signature policy is an error, instead of the default.
+func TestGetPolicy(t *testing.T) {
+	policy, err := PluginSignatures{Policy: "required"}.GetPolicy()
+	require.NoError(t, err)
+	assert.Equal(t, SignaturesRequired, policy)
+
+	_, err = PluginSignatures{Policy: "requried"}.GetPolicy()
+	assert.ErrorContains(t, err, `"pluginSignatures.policy" is invalid: "requried"`)
+}
+
 // TestGetDefaultConfigFilePath tests the GetDefaultConfigFilePath function.
 func TestGetDefaultConfigFilePath(t *testing.T) {
 	assert.Equal(t, GlobalConfigFilename, GetDefaultConfigFilePath(GlobalConfigFilename))
@@ -65,3 +75,16 @@ func TestFilterWithMissingGroupName(t *testing.T) {
 	defaultGroup := conf.Global.Filter("missing")
 	assert.Empty(t, defaultGroup)
 }
+
+// TestRedacted tests that the tokens of the API credentials are redacted.
+func TestRedacted(t *testing.T) {
+	api := API{Auth: APIAuth{
+		Method:      AuthMethodBearer,
+		Credentials: []APICredential{{Name: "admin", Token: "secret", Role: "admin"}},
+	}}
+	redacted := api.Redacted()
+	assert.Equal(t, RedactedSecret, redacted.Auth.Credentials[0].Token)
+	assert.Equal(t, "admin", redacted.Auth.Credentials[0].Name)
+	// The original config keeps the token.
+	assert.Equal(t, "secret", api.Auth.Credentials[0].Token)
+}
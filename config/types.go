@@ -3,6 +3,8 @@ package config
 
 import (
 	"time"
+
+	"github.com/invopop/jsonschema"
 )
 
 type Plugin struct {
@@ -13,6 +15,60 @@ type Plugin struct {
 	Env       []string `json:"env" jsonschema:"required"`
 	Checksum  string   `json:"checksum" jsonschema:"required"`
 	URL       string   `json:"url"`
+	// Version is the version of the plugin that is installed from the URL, if it is known.
+	Version string `json:"version,omitempty"`
+	// Required plugins must be loaded for the GatewayD to be ready.
+	Required bool `json:"required"`
+	// Priority orders the hooks of the plugins, from the lowest to the highest priority. It
+	// is determined by the position of the plugin in the list if it isn't set. HookPriorities
+	// override the priority of the given hooks.
+	Priority       uint            `json:"priority,omitempty"`
+	HookPriorities map[string]uint `json:"hookPriorities,omitempty"`
+	// Timeout limits each call to the hooks of the plugin, and HookTimeouts limit the
+	// calls to the given hooks. The global plugin timeout is used if they aren't set.
+	Timeout      time.Duration            `json:"timeout" jsonschema:"oneof_type=string;integer"`
+	HookTimeouts map[string]time.Duration `json:"hookTimeouts"`
+	// FailureMode decides whether the traffic is passed on (open) or the connection is closed
+	// (closed) when the plugin fails or its circuit breaker is open. Only the connection and
+	// traffic hooks and OnNewClient can fail closed; the other hooks always fail open.
+	FailureMode    string         `json:"failureMode" jsonschema:"enum=open,enum=closed"`
+	CircuitBreaker CircuitBreaker `json:"circuitBreaker"`
+	// Isolation limits the resources and the privileges of the plugin subprocess.
+	Isolation PluginIsolation `json:"isolation,omitempty"`
+	// Signature is the path of the detached signature of the plugin binary. It defaults to
+	// the local path with the .minisig or the .sig extension, if either file exists.
+	Signature string `json:"signature,omitempty"`
+}
+
+// PluginIsolation limits the resources and the privileges of a plugin subprocess, so that
+// a misbehaving plugin can't take the host down or read the secrets of other plugins. The
+// memory and CPU limits are set on a cgroup v2 that is created for the plugin in the Cgroup
+// directory. If Cgroup isn't set, the memory is limited with an rlimit on the data segment
+// once the plugin is started, and the CPU can't be limited.
+type PluginIsolation struct {
+	// MemoryLimit is the maximum memory of the plugin, e.g. 512MiB.
+	MemoryLimit string `json:"memoryLimit,omitempty"`
+	// CPULimit is the maximum number of CPUs the plugin can use, e.g. 0.5.
+	CPULimit float64 `json:"cpuLimit,omitempty"`
+	// MaxOpenFiles limits the number of files the plugin can open.
+	MaxOpenFiles uint64 `json:"maxOpenFiles,omitempty"`
+	Cgroup       string `json:"cgroup,omitempty"`
+	WorkingDir   string `json:"workingDir,omitempty"`
+	// User and Group are the names or the IDs of the user and the group the plugin runs
+	// as. The group of the user is used if Group isn't set.
+	User  string `json:"user,omitempty"`
+	Group string `json:"group,omitempty"`
+	// EnvAllowlist are the environment variables of GatewayD that are passed to the plugin,
+	// besides its own env. The names that end with * match their prefix. All of them are
+	// passed if the allowlist isn't set.
+	EnvAllowlist []string `json:"envAllowlist,omitempty"`
+}
+
+// CircuitBreaker skips the hooks of a plugin for the cool-down period after the given
+// number of consecutive failures, and then tries them again.
+type CircuitBreaker struct {
+	FailureThreshold int           `json:"failureThreshold"`
+	CoolDown         time.Duration `json:"coolDown" jsonschema:"oneof_type=string;integer"`
 }
 
 type Policy struct {
@@ -21,6 +77,16 @@ type Policy struct {
 	Metadata map[string]any `json:"metadata,omitempty"`
 }
 
+// ExpressionHook is a hook that evaluates an expression instead of calling a plugin. The
+// expression gets the hook payload and returns the signals and the modified fields. It is
+// run in the order of its priority among the hooks of the plugins.
+type ExpressionHook struct {
+	Name       string `json:"name" jsonschema:"required"`
+	Hook       string `json:"hook" jsonschema:"required"`
+	Priority   uint   `json:"priority" jsonschema:"required"`
+	Expression string `json:"expression" jsonschema:"required"`
+}
+
 type PluginConfig struct {
 	CompatibilityPolicy string        `json:"compatibilityPolicy" jsonschema:"enum=strict,enum=loose"`
 	EnableMetricsMerger bool          `json:"enableMetricsMerger"`
@@ -34,6 +100,13 @@ type PluginConfig struct {
 	PolicyTimeout       time.Duration `json:"policyTimeout" jsonschema:"oneof_type=string;integer"`
 	ActionTimeout       time.Duration `json:"actionTimeout" jsonschema:"oneof_type=string;integer"`
 	Policies            []Policy      `json:"policies"`
+	// AsyncHooks are run by a pool of workers in the background, instead of delaying the
+	// traffic, since their results are ignored. The events are dropped if the queue is full.
+	AsyncHooks     []string `json:"asyncHooks"`
+	AsyncWorkers   int      `json:"asyncWorkers"`
+	AsyncQueueSize int      `json:"asyncQueueSize"`
+	// ExpressionHooks are lightweight hooks that are defined by expressions.
+	ExpressionHooks []ExpressionHook `json:"expressionHooks"`
 }
 
 type Client struct {
@@ -87,6 +160,7 @@ type Pool struct {
 
 type Proxy struct {
 	HealthCheckPeriod time.Duration `json:"healthCheckPeriod" jsonschema:"oneof_type=string;integer"`
+	SkipCopyHooks     bool          `json:"skipCopyHooks"`
 }
 
 type Server struct {
@@ -98,21 +172,70 @@ type Server struct {
 	CertFile         string        `json:"certFile"`
 	KeyFile          string        `json:"keyFile"`
 	HandshakeTimeout time.Duration `json:"handshakeTimeout" jsonschema:"oneof_type=string;integer"`
+
+	IdleTimeout              time.Duration `json:"idleTimeout" jsonschema:"oneof_type=string;integer"`
+	IdleInTransactionTimeout time.Duration `json:"idleInTransactionTimeout" jsonschema:"oneof_type=string;integer"`
+	MaxSessionDuration       time.Duration `json:"maxSessionDuration" jsonschema:"oneof_type=string;integer"`
+
+	AdminDatabase string `json:"adminDatabase"`
+}
+
+type APICredential struct {
+	Name  string `json:"name"`
+	Token string `json:"token"`
+	Role  string `json:"role" jsonschema:"enum=readonly,enum=admin"`
+}
+
+type APIAuth struct {
+	Method      string          `json:"method" jsonschema:"enum=none,enum=bearer,enum=apiKey"`
+	Credentials []APICredential `json:"credentials"`
+}
+
+type Readiness struct {
+	// PoolThreshold is the ratio of the busy connections to the size of a pool,
+	// from which the pool is considered to be exhausted.
+	PoolThreshold float64       `json:"poolThreshold"`
+	DialTimeout   time.Duration `json:"dialTimeout" jsonschema:"oneof_type=string;integer"`
+}
+
+// PluginSignatures decides whether the plugins must be signed by one of the TrustedKeys,
+// which are minisign public keys, PEM public keys, or the paths of their files. They are
+// in the global config, because the plugins config is changed by the plugin install command.
+type PluginSignatures struct {
+	Policy      string   `json:"policy" jsonschema:"enum=disabled,enum=optional,enum=required"`
+	TrustedKeys []string `json:"trustedKeys"`
 }
 
 type API struct {
-	Enabled     bool   `json:"enabled"`
-	HTTPAddress string `json:"httpAddress"`
-	GRPCAddress string `json:"grpcAddress"`
-	GRPCNetwork string `json:"grpcNetwork" jsonschema:"enum=tcp,enum=udp,enum=unix"`
+	Enabled      bool      `json:"enabled"`
+	HTTPAddress  string    `json:"httpAddress"`
+	GRPCAddress  string    `json:"grpcAddress"`
+	GRPCNetwork  string    `json:"grpcNetwork" jsonschema:"enum=tcp,enum=udp,enum=unix"`
+	EnableTLS    bool      `json:"enableTLS"`
+	CertFile     string    `json:"certFile"`
+	KeyFile      string    `json:"keyFile"`
+	ClientCAFile string    `json:"clientCAFile"`
+	Auth         APIAuth   `json:"auth"`
+	Readiness    Readiness `json:"readiness"`
 }
 
 type GlobalConfig struct {
-	API     API                 `json:"api"`
-	Loggers map[string]*Logger  `json:"loggers"`
-	Clients map[string]*Client  `json:"clients"`
-	Pools   map[string]*Pool    `json:"pools"`
-	Proxies map[string]*Proxy   `json:"proxies"`
-	Servers map[string]*Server  `json:"servers"`
-	Metrics map[string]*Metrics `json:"metrics"`
+	API              API                 `json:"api"`
+	Loggers          map[string]*Logger  `json:"loggers"`
+	Clients          map[string]*Client  `json:"clients"`
+	Pools            map[string]*Pool    `json:"pools"`
+	Proxies          map[string]*Proxy   `json:"proxies"`
+	Servers          map[string]*Server  `json:"servers"`
+	Metrics          map[string]*Metrics `json:"metrics"`
+	PluginSignatures PluginSignatures    `json:"pluginSignatures"`
+}
+
+// JSONSchemaExtend allows the hook timeouts to be strings or integers, like the other
+// durations, since the tags can't be applied to the values of a map.
+func (Plugin) JSONSchemaExtend(schema *jsonschema.Schema) {
+	if hookTimeouts, ok := schema.Properties.Get("hookTimeouts"); ok {
+		hookTimeouts.AdditionalProperties = &jsonschema.Schema{
+			OneOf: []*jsonschema.Schema{{Type: "string"}, {Type: "integer"}},
+		}
+	}
 }
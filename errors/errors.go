@@ -52,6 +52,12 @@ const (
 	ErrCodeEvalError
 	ErrCodeMsgEncodeError
 	ErrCodeConfigParseError
+	ErrCodeRelayFailed
+	ErrCodeLoadPluginFailed
+	ErrCodePluginAlreadyLoaded
+	ErrCodeAuthenticationFailed
+	ErrCodePolicyAlreadyExists
+	ErrCodePluginFailedClosed
 )
 
 var (
@@ -77,6 +83,15 @@ var (
 		ErrCodePoolExhausted, "pool is exhausted", nil,
 	}
 
+	ErrPluginNotFound = &GatewayDError{
+		ErrCodePluginNotFound, "plugin not found", nil,
+	}
+	ErrPluginAlreadyLoaded = &GatewayDError{
+		ErrCodePluginAlreadyLoaded, "plugin is already loaded", nil,
+	}
+	ErrFailedToLoadPlugin = &GatewayDError{
+		ErrCodeLoadPluginFailed, "failed to load plugin", nil,
+	}
 	ErrPluginNotReady = &GatewayDError{
 		ErrCodePluginNotReady, "plugin is not ready", nil,
 	}
@@ -104,6 +119,9 @@ var (
 	ErrFailedToReadPluginScaffoldInputFile = &GatewayDError{
 		ErrCodePluginScaffoldInputFileReadFailed, "failed to read plugin scaffold input file", nil,
 	}
+	ErrPluginFailedClosed = &GatewayDError{
+		ErrCodePluginFailedClosed, "plugin failed closed", nil,
+	}
 
 	ErrClientReceiveFailed = &GatewayDError{
 		ErrCodeClientReceiveFailed, "couldn't receive data from the server", nil,
@@ -134,6 +152,15 @@ var (
 	ErrReadFailed = &GatewayDError{
 		ErrCodeReadFailed, "failed to read from the client", nil,
 	}
+	ErrRelayFailed = &GatewayDError{
+		ErrCodeRelayFailed, "failed to relay the traffic", nil,
+	}
+	ErrConnectionNotFound = &GatewayDError{
+		ErrCodeKeyNotFound, "connection not found", nil,
+	}
+	ErrAuthenticationFailed = &GatewayDError{
+		ErrCodeAuthenticationFailed, "failed to authenticate the client", nil,
+	}
 
 	ErrNilPointer = &GatewayDError{
 		ErrCodeNilPointer, "nil pointer", nil,
@@ -179,6 +206,12 @@ var (
 	ErrPolicyNotMatched = &GatewayDError{
 		ErrCodeKeyNotFound, "no matching policy", nil,
 	}
+	ErrPolicyNotExist = &GatewayDError{
+		ErrCodeKeyNotFound, "policy does not exist", nil,
+	}
+	ErrPolicyAlreadyExists = &GatewayDError{
+		ErrCodePolicyAlreadyExists, "policy already exists", nil,
+	}
 	ErrEvalError = &GatewayDError{
 		ErrCodeEvalError, "error evaluating expression", nil,
 	}
@@ -195,9 +228,10 @@ var (
 )
 
 const (
-	FailedToCreateClient      = 1
-	FailedToInitializePool    = 2
-	FailedToStartServer       = 3
-	FailedToStartTracer       = 4
-	FailedToCreateActRegistry = 5
+	FailedToCreateClient         = 1
+	FailedToInitializePool       = 2
+	FailedToStartServer          = 3
+	FailedToStartTracer          = 4
+	FailedToCreateActRegistry    = 5
+	FailedToCreatePluginRegistry = 6
 )
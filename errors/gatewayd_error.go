@@ -18,10 +18,19 @@ func (e *GatewayDError) Error() string {
 	return fmt.Sprintf("%s, OriginalError: %s", e.Message, e.OriginalError)
 }
 
-// Wrap wraps the original error.
+// Wrap returns a copy of the error that wraps the original error. The error isn't
+// changed, because the package-level errors are shared by the goroutines.
 func (e *GatewayDError) Wrap(err error) *GatewayDError {
-	e.OriginalError = err
-	return e
+	wrapped := *e
+	wrapped.OriginalError = err
+	return &wrapped
+}
+
+// Is returns true if the target is the same error, regardless of the original error,
+// so that the wrapped copies of the package-level errors match them in errors.Is.
+func (e *GatewayDError) Is(target error) bool {
+	t, ok := target.(*GatewayDError)
+	return ok && t.Code == e.Code && t.Message == e.Message
 }
 
 // Unwrap returns the original error.
@@ -0,0 +1,202 @@
+package events
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/gatewayd-io/gatewayd/metrics"
+	"github.com/rs/zerolog"
+)
+
+// Type is the type of an event.
+type Type string
+
+const (
+	ConnectionOpened       Type = "connection.opened"
+	ConnectionClosed       Type = "connection.closed"
+	ServerStarted          Type = "server.started"
+	ServerStopped          Type = "server.stopped"
+	ProxyPaused            Type = "proxy.paused"
+	ProxyResumed           Type = "proxy.resumed"
+	PoolExhausted          Type = "pool.exhausted"
+	BackendReconnectFailed Type = "backend.reconnect_failed"
+	PluginLoaded           Type = "plugin.loaded"
+	PluginUnloaded         Type = "plugin.unloaded"
+	PluginCrashed          Type = "plugin.crashed"
+	PluginReloaded         Type = "plugin.reloaded"
+	PolicyMatched          Type = "policy.matched"
+	ConfigReloaded         Type = "config.reloaded"
+)
+
+// Types are all the types of the events.
+var Types = []Type{
+	ConnectionOpened,
+	ConnectionClosed,
+	ServerStarted,
+	ServerStopped,
+	ProxyPaused,
+	ProxyResumed,
+	PoolExhausted,
+	BackendReconnectFailed,
+	PluginLoaded,
+	PluginUnloaded,
+	PluginCrashed,
+	PluginReloaded,
+	PolicyMatched,
+	ConfigReloaded,
+}
+
+// Event is something that happened in the GatewayD, e.g. a client connection was opened.
+type Event struct {
+	// ID increases with each event, so the subscribers can tell if they missed any.
+	ID   uint64
+	Type Type
+	Time time.Time
+	// Source is the component that published the event, e.g. server or plugin.
+	Source string
+	Data   map[string]interface{}
+}
+
+// Bus delivers the events that the components of the GatewayD publish to the subscribers,
+// e.g. the clients of the event stream API. A nil bus drops the events, so the components
+// can publish events without checking if the bus is set.
+type Bus struct {
+	Logger zerolog.Logger
+	// BufferSize is the number of events that a subscriber can fall behind by. The events
+	// that don't fit in the buffer are dropped, so that a slow subscriber never blocks the
+	// component that publishes the event.
+	BufferSize int
+
+	mu          *sync.RWMutex
+	subscribers map[uint64]*subscriber
+	lastEventID uint64
+	lastSubID   uint64
+	closed      bool
+}
+
+type subscriber struct {
+	types  []Type
+	events chan Event
+}
+
+// NewBus creates a new event bus.
+func NewBus(bus Bus) *Bus {
+	if bus.BufferSize <= 0 {
+		bus.BufferSize = config.DefaultEventBufferSize
+	}
+
+	return &Bus{
+		Logger:      bus.Logger,
+		BufferSize:  bus.BufferSize,
+		mu:          &sync.RWMutex{},
+		subscribers: map[uint64]*subscriber{},
+	}
+}
+
+// Publish sends the event to the subscribers of its type, without waiting for them.
+func (b *Bus) Publish(eventType Type, source string, data map[string]interface{}) {
+	if b == nil {
+		return
+	}
+
+	event := Event{
+		ID:     atomic.AddUint64(&b.lastEventID, 1),
+		Type:   eventType,
+		Time:   time.Now(),
+		Source: source,
+		Data:   data,
+	}
+	metrics.EventsPublished.WithLabelValues(string(eventType)).Inc()
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, sub := range b.subscribers {
+		if len(sub.types) > 0 && !slices.Contains(sub.types, eventType) {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			metrics.EventsDropped.Inc()
+			b.Logger.Trace().Fields(
+				map[string]interface{}{
+					"id":   event.ID,
+					"type": eventType,
+				},
+			).Msg("Dropped the event, because the subscriber is too slow")
+		}
+	}
+}
+
+// Subscribe returns a channel that receives the events of the given types, or all the
+// events if no types are given, and a function that cancels the subscription and closes
+// the channel.
+func (b *Bus) Subscribe(types ...Type) (<-chan Event, func()) {
+	if b == nil {
+		// No events are ever published, so the channel never receives any.
+		return make(chan Event), func() {}
+	}
+
+	sub := &subscriber{
+		types:  types,
+		events: make(chan Event, b.BufferSize),
+	}
+
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		close(sub.events)
+		return sub.events, func() {}
+	}
+	b.lastSubID++
+	id := b.lastSubID
+	b.subscribers[id] = sub
+	b.mu.Unlock()
+
+	var once sync.Once
+	return sub.events, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			// The channel is already closed if the bus is closed.
+			if _, ok := b.subscribers[id]; ok {
+				delete(b.subscribers, id)
+				close(sub.events)
+			}
+		})
+	}
+}
+
+// Subscribers returns the number of subscribers.
+func (b *Bus) Subscribers() int {
+	if b == nil {
+		return 0
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscribers)
+}
+
+// Close closes the channels of the subscribers, so that they stop waiting for events,
+// e.g. before the API servers are shut down. The events that are published afterwards
+// are dropped.
+func (b *Bus) Close() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for id, sub := range b.subscribers {
+		delete(b.subscribers, id)
+		close(sub.events)
+	}
+}
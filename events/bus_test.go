@@ -0,0 +1,90 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBus tests that the events are delivered to the subscribers of their types.
+func TestBus(t *testing.T) {
+	bus := NewBus(Bus{Logger: zerolog.Nop(), BufferSize: 2})
+	all, unsubscribeAll := bus.Subscribe()
+	defer unsubscribeAll()
+	closed, unsubscribeClosed := bus.Subscribe(ConnectionClosed)
+	assert.Equal(t, 2, bus.Subscribers())
+
+	bus.Publish(ConnectionOpened, "server", map[string]interface{}{"id": 1})
+	bus.Publish(ConnectionClosed, "server", map[string]interface{}{"id": 1})
+
+	event := <-all
+	assert.Equal(t, uint64(1), event.ID)
+	assert.Equal(t, ConnectionOpened, event.Type)
+	assert.Equal(t, "server", event.Source)
+	assert.Equal(t, 1, event.Data["id"])
+	event = <-all
+	assert.Equal(t, ConnectionClosed, event.Type)
+
+	event = <-closed
+	assert.Equal(t, uint64(2), event.ID)
+	assert.Equal(t, ConnectionClosed, event.Type)
+
+	unsubscribeClosed()
+	unsubscribeClosed()
+	_, ok := <-closed
+	assert.False(t, ok)
+	assert.Equal(t, 1, bus.Subscribers())
+}
+
+// TestBusSlowSubscriber tests that the events are dropped for a slow subscriber.
+func TestBusSlowSubscriber(t *testing.T) {
+	bus := NewBus(Bus{Logger: zerolog.Nop(), BufferSize: 1})
+	events, unsubscribe := bus.Subscribe()
+	defer unsubscribe()
+
+	bus.Publish(PoolExhausted, "proxy", nil)
+	bus.Publish(PoolExhausted, "proxy", nil)
+	bus.Publish(PoolExhausted, "proxy", nil)
+
+	event := <-events
+	assert.Equal(t, uint64(1), event.ID)
+	assert.Empty(t, events)
+
+	// The next event shows the gap of the dropped events.
+	bus.Publish(PoolExhausted, "proxy", nil)
+	event = <-events
+	assert.Equal(t, uint64(4), event.ID)
+}
+
+// TestBusClose tests that closing the bus closes the channels of the subscribers.
+func TestBusClose(t *testing.T) {
+	bus := NewBus(Bus{Logger: zerolog.Nop()})
+	assert.Equal(t, config.DefaultEventBufferSize, bus.BufferSize)
+	events, unsubscribe := bus.Subscribe()
+
+	bus.Close()
+	_, ok := <-events
+	assert.False(t, ok)
+	unsubscribe()
+
+	events, _ = bus.Subscribe()
+	_, ok = <-events
+	assert.False(t, ok)
+	assert.Zero(t, bus.Subscribers())
+	bus.Publish(ConfigReloaded, "api", nil)
+}
+
+// TestNilBus tests that a nil bus drops the events.
+func TestNilBus(t *testing.T) {
+	var bus *Bus
+	require.NotPanics(t, func() {
+		bus.Publish(ConnectionOpened, "server", nil)
+		_, unsubscribe := bus.Subscribe()
+		unsubscribe()
+		bus.Close()
+	})
+	assert.Zero(t, bus.Subscribers())
+}
@@ -70,11 +70,31 @@ var (
 		Name:      "plugin_hooks_executed_total",
 		Help:      "Number of plugin hooks executed",
 	})
+	PluginAsyncHooksDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "plugin_async_hooks_dropped_total",
+		Help:      "Number of async hook calls dropped, because the queue was full",
+	})
+	PluginHookFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "plugin_hook_failures_total",
+		Help:      "Number of hook calls that failed, timed out or were skipped by the circuit breaker",
+	}, []string{"plugin", "hook"})
+	PluginCircuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "plugin_circuit_breaker_state",
+		Help:      "State of the circuit breaker of each plugin (0: closed, 1: half-open, 2: open)",
+	}, []string{"plugin"})
 	ProxyHealthChecks = promauto.NewCounter(prometheus.CounterOpts{
 		Namespace: Namespace,
 		Name:      "proxy_health_checks_total",
 		Help:      "Number of proxy health checks",
 	})
+	SessionTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "session_timeouts_total",
+		Help:      "Number of client sessions terminated due to a timeout",
+	}, []string{"timeout"})
 	ProxiedConnections = promauto.NewGauge(prometheus.GaugeOpts{
 		Namespace: Namespace,
 		Name:      "proxied_connections",
@@ -95,6 +115,16 @@ var (
 		Name:      "proxy_passthrough_terminations_total",
 		Help:      "Number of proxy passthrough terminations by plugins",
 	})
+	CopyOperations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "copy_operations_total",
+		Help:      "Number of COPY operations passed through GatewayD",
+	}, []string{"direction"})
+	BytesCopied = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "copy_bytes_total",
+		Help:      "Number of bytes of COPY data passed through GatewayD",
+	}, []string{"direction"})
 	APIRequests = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: Namespace,
 		Name:      "api_requests_total",
@@ -105,4 +135,14 @@ var (
 		Name:      "api_requests_errors_total",
 		Help:      "Number of API request errors",
 	}, []string{"method", "endpoint", "error"})
+	EventsPublished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "events_published_total",
+		Help:      "Number of events published to the event stream",
+	}, []string{"type"})
+	EventsDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "events_dropped_total",
+		Help:      "Number of events dropped, because the subscribers were too slow",
+	})
 )
@@ -0,0 +1,213 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gatewayd-io/gatewayd/config"
+	gerr "github.com/gatewayd-io/gatewayd/errors"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"go.opentelemetry.io/otel"
+)
+
+// textOID is the OID of the text type, which is the type of all the admin console columns.
+const textOID = 25
+
+// AdminResult is the result of an admin console command, which is sent to the client
+// as rows of text columns, followed by the command tag. A result without columns has no rows.
+type AdminResult struct {
+	Columns []string
+	Rows    [][]string
+	Tag     string
+}
+
+// AdminClient is the client of an admin session.
+type AdminClient struct {
+	User    string
+	Address string
+}
+
+// IAdminConsole authenticates the clients of the admin console and runs their
+// commands, e.g. "SHOW POOLS".
+type IAdminConsole interface {
+	Authenticate(client AdminClient, password string) error
+	Run(ctx context.Context, client AdminClient, command string) (*AdminResult, error)
+}
+
+// serveAdminConsole answers the client of an admin session locally, instead of passing its
+// traffic to the server, until the client terminates the session. The client must send
+// its password first. Only the simple query protocol is supported, which is what psql uses.
+func (pr *Proxy) serveAdminConsole(conn *ConnWrapper) *gerr.GatewayDError {
+	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "serveAdminConsole")
+	defer span.End()
+
+	user, database := conn.session.Startup()
+	pr.Logger.Info().Fields(
+		map[string]interface{}{
+			"user":     user,
+			"database": database,
+			"remote":   RemoteAddr(conn.Conn()),
+		},
+	).Msg("Client connected to the admin console")
+
+	client := AdminClient{User: user, Address: RemoteAddr(conn.Conn())}
+	backend := pgproto3.NewBackend(conn, conn)
+	if err := pr.authenticateAdmin(backend, conn.session.adminConsole, client); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	backend.Send(&pgproto3.AuthenticationOk{})
+	for name, value := range map[string]string{
+		"server_version":              config.Version + "/gatewayd",
+		"server_encoding":             "UTF8",
+		"client_encoding":             "UTF8",
+		"DateStyle":                   "ISO",
+		"integer_datetimes":           "on",
+		"standard_conforming_strings": "on",
+	} {
+		backend.Send(&pgproto3.ParameterStatus{Name: name, Value: value})
+	}
+	backend.Send(&pgproto3.ReadyForQuery{TxStatus: txIdle})
+
+	for {
+		if err := backend.Flush(); err != nil {
+			span.RecordError(err)
+			return gerr.ErrServerSendFailed.Wrap(err)
+		}
+
+		message, err := backend.Receive()
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return gerr.ErrClientNotConnected.Wrap(err)
+			}
+			span.RecordError(err)
+			return gerr.ErrReadFailed.Wrap(err)
+		}
+
+		switch message := message.(type) {
+		case *pgproto3.Query:
+			pr.runAdminCommands(backend, conn.session.adminConsole, client, message.String)
+			backend.Send(&pgproto3.ReadyForQuery{TxStatus: txIdle})
+		case *pgproto3.Sync:
+			backend.Send(adminError("the admin console only supports the simple query protocol"))
+			backend.Send(&pgproto3.ReadyForQuery{TxStatus: txIdle})
+		case *pgproto3.Terminate:
+			return gerr.ErrClientNotConnected
+		default:
+			// The messages of the extended query protocol are answered at the Sync message.
+		}
+	}
+}
+
+// authenticateAdmin asks the client of an admin session for its password. The password
+// is sent in cleartext, so the clients should connect with TLS. The client receives
+// a FATAL error if the password is wrong.
+func (pr *Proxy) authenticateAdmin(
+	backend *pgproto3.Backend, console IAdminConsole, client AdminClient,
+) *gerr.GatewayDError {
+	backend.Send(&pgproto3.AuthenticationCleartextPassword{})
+	if err := backend.Flush(); err != nil {
+		return gerr.ErrServerSendFailed.Wrap(err)
+	}
+
+	if err := backend.SetAuthType(pgproto3.AuthTypeCleartextPassword); err != nil {
+		return gerr.ErrReadFailed.Wrap(err)
+	}
+	message, err := backend.Receive()
+	if err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return gerr.ErrClientNotConnected.Wrap(err)
+		}
+		return gerr.ErrReadFailed.Wrap(err)
+	}
+
+	if password, ok := message.(*pgproto3.PasswordMessage); !ok {
+		err = fmt.Errorf("expected a password message, got %T", message) //nolint:goerr113
+	} else {
+		err = console.Authenticate(client, password.Password)
+	}
+	if err != nil {
+		pr.Logger.Warn().Err(err).Fields(
+			map[string]interface{}{
+				"user":   client.User,
+				"remote": client.Address,
+			},
+		).Msg("Failed to authenticate the client of the admin console")
+
+		backend.Send(&pgproto3.ErrorResponse{
+			Severity:            "FATAL",
+			SeverityUnlocalized: "FATAL",
+			// invalid_password
+			Code:    "28P01",
+			Message: fmt.Sprintf("password authentication failed for user %q", client.User),
+		})
+		_ = backend.Flush()
+		return gerr.ErrAuthenticationFailed.Wrap(err)
+	}
+
+	return nil
+}
+
+// runAdminCommands runs the commands of a query one by one and sends their results,
+// until a command fails.
+func (pr *Proxy) runAdminCommands(
+	backend *pgproto3.Backend, console IAdminConsole, client AdminClient, query string,
+) {
+	commands := make([]string, 0)
+	for _, command := range strings.Split(query, ";") {
+		if command = strings.TrimSpace(command); command != "" {
+			commands = append(commands, command)
+		}
+	}
+	if len(commands) == 0 {
+		backend.Send(&pgproto3.EmptyQueryResponse{})
+		return
+	}
+
+	for _, command := range commands {
+		pr.Logger.Debug().Str("command", command).Msg("Running admin console command")
+
+		result, err := console.Run(pr.ctx, client, command)
+		if err != nil {
+			backend.Send(adminError(err.Error()))
+			return
+		}
+
+		if len(result.Columns) > 0 {
+			fields := make([]pgproto3.FieldDescription, 0, len(result.Columns))
+			for _, column := range result.Columns {
+				fields = append(fields, pgproto3.FieldDescription{
+					Name:         []byte(column),
+					DataTypeOID:  textOID,
+					DataTypeSize: -1,
+					TypeModifier: -1,
+				})
+			}
+			backend.Send(&pgproto3.RowDescription{Fields: fields})
+
+			for _, row := range result.Rows {
+				values := make([][]byte, 0, len(row))
+				for _, value := range row {
+					values = append(values, []byte(value))
+				}
+				backend.Send(&pgproto3.DataRow{Values: values})
+			}
+		}
+		backend.Send(&pgproto3.CommandComplete{CommandTag: []byte(result.Tag)})
+	}
+}
+
+// adminError returns the error response of a failed admin console command.
+func adminError(message string) *pgproto3.ErrorResponse {
+	return &pgproto3.ErrorResponse{
+		Severity:            "ERROR",
+		SeverityUnlocalized: "ERROR",
+		// feature_not_supported
+		Code:    "0A000",
+		Message: message,
+	}
+}
@@ -19,6 +19,7 @@ import (
 type IClient interface {
 	Send(data []byte) (int, *gerr.GatewayDError)
 	Receive() (int, []byte, *gerr.GatewayDError)
+	ReceiveChunk() (int, []byte, *gerr.GatewayDError)
 	Reconnect() error
 	Close()
 	IsConnected() bool
@@ -234,16 +235,17 @@ func (c *Client) Receive() (int, []byte, *gerr.GatewayDError) {
 	var received int
 	buffer := bytes.NewBuffer(nil)
 	// Read the data in chunks.
+	chunk := getBuffer(c.ReceiveChunkSize)
+	defer putBuffer(chunk)
 	for ctx.Err() == nil {
-		chunk := make([]byte, c.ReceiveChunkSize)
-		read, err := c.conn.Read(chunk)
+		read, err := c.conn.Read(*chunk)
 		if err != nil {
 			c.logger.Error().Err(err).Msg("Couldn't receive data from the server")
 			span.RecordError(err)
 			return received, buffer.Bytes(), gerr.ErrClientReceiveFailed.Wrap(err)
 		}
 		received += read
-		buffer.Write(chunk[:read])
+		buffer.Write((*chunk)[:read])
 
 		if read == 0 || read < c.ReceiveChunkSize {
 			break
@@ -255,6 +257,31 @@ func (c *Client) Receive() (int, []byte, *gerr.GatewayDError) {
 	return received, buffer.Bytes(), nil
 }
 
+// ReceiveChunk receives at most one chunk of data from the server. Unlike Receive, it
+// doesn't wait for the rest of the data, so the memory used for streaming large
+// responses, like the data of COPY ... TO STDOUT, is bounded by the chunk size.
+func (c *Client) ReceiveChunk() (int, []byte, *gerr.GatewayDError) {
+	_, span := otel.Tracer(config.TracerName).Start(c.ctx, "ReceiveChunk")
+	defer span.End()
+
+	if !c.connected.Load() {
+		span.RecordError(gerr.ErrClientNotConnected)
+		return 0, nil, gerr.ErrClientNotConnected
+	}
+
+	chunk := make([]byte, c.ReceiveChunkSize)
+	read, err := c.conn.Read(chunk)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Couldn't receive data from the server")
+		span.RecordError(err)
+		return read, chunk[:read], gerr.ErrClientReceiveFailed.Wrap(err)
+	}
+
+	span.AddEvent("Received data from server")
+
+	return read, chunk[:read], nil
+}
+
 // Reconnect reconnects to the server.
 func (c *Client) Reconnect() error {
 	_, span := otel.Tracer(config.TracerName).Start(c.ctx, "Reconnect")
@@ -4,9 +4,13 @@ package network
 import (
 	"context"
 	"crypto/tls"
+	"io"
 	"net"
+	"sync"
 	"time"
 
+	"github.com/gatewayd-io/gatewayd-plugin-sdk/databases/postgres"
+	"github.com/gatewayd-io/gatewayd/config"
 	gerr "github.com/gatewayd-io/gatewayd/errors"
 )
 
@@ -35,6 +39,12 @@ type ConnWrapper struct {
 	TLSConfig        *tls.Config
 	isTLSEnabled     bool
 	HandshakeTimeout time.Duration
+
+	// session keeps the state of the client session on the connection.
+	session *session
+	// writeMu serializes the writes to the client, because the server sends
+	// messages to the client while the responses are passed through.
+	writeMu *sync.Mutex
 }
 
 var _ IConnWrapper = (*ConnWrapper)(nil)
@@ -76,20 +86,48 @@ func (cw *ConnWrapper) UpgradeToTLS(upgrader UpgraderFunc) *gerr.GatewayDError {
 
 // Close closes the connection.
 func (cw *ConnWrapper) Close() error {
+	cw.session.Close()
 	if cw.tlsConn != nil {
 		return cw.tlsConn.Close()
 	}
 	return cw.NetConn.Close()
 }
 
-// Write writes data to the connection.
+// Write writes data to the connection. The data is never interleaved with
+// the data of the other writes.
 func (cw *ConnWrapper) Write(data []byte) (int, error) {
+	cw.writeMu.Lock()
+	defer cw.writeMu.Unlock()
+
 	if cw.tlsConn != nil {
 		return cw.tlsConn.Write(data)
 	}
 	return cw.NetConn.Write(data)
 }
 
+// ReadFrom writes the data read from the reader to the connection, like Write, so the
+// relayed traffic is never interleaved with the other writes either. The underlying
+// connection reads the data directly if it can, e.g. with splice on Linux.
+func (cw *ConnWrapper) ReadFrom(reader io.Reader) (int64, error) {
+	cw.writeMu.Lock()
+	defer cw.writeMu.Unlock()
+
+	if readerFrom, ok := cw.Conn().(io.ReaderFrom); ok {
+		return readerFrom.ReadFrom(reader)
+	}
+
+	buffer := getBuffer(config.DefaultChunkSize)
+	defer putBuffer(buffer)
+	return io.CopyBuffer(cw.Conn(), reader, *buffer)
+}
+
+// writeFatal sends a FATAL error with the reason why the session is terminated to the
+// client. The error is written like the responses, so it's never interleaved with them.
+func (cw *ConnWrapper) writeFatal(reason *sessionTimeout) error {
+	_, err := cw.Write(postgres.ErrorResponse(reason.Message, "FATAL", reason.Code, ""))
+	return err
+}
+
 // Read reads data from the connection.
 func (cw *ConnWrapper) Read(data []byte) (int, error) {
 	if cw.tlsConn != nil {
@@ -129,6 +167,8 @@ func NewConnWrapper(
 		TLSConfig:        connWrapper.TLSConfig,
 		isTLSEnabled:     connWrapper.TLSConfig != nil && connWrapper.TLSConfig.Certificates != nil,
 		HandshakeTimeout: connWrapper.HandshakeTimeout,
+		session:          newSession(),
+		writeMu:          &sync.Mutex{},
 	}
 }
 
@@ -1,12 +1,23 @@
 package network
 
 import (
+	"context"
 	"encoding/binary"
+	"io"
+	"net"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/gatewayd-io/gatewayd/act"
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/gatewayd-io/gatewayd/plugin"
+	"github.com/gatewayd-io/gatewayd/pool"
+	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -70,6 +81,178 @@ func CreatePgTerminatePacket() []byte {
 	return []byte{'X', 0, 0, 0, 4}
 }
 
+// StartFakeBackend starts a TCP server that acts as a database and calls the handler
+// for every new connection. It returns the address of the server, which is closed
+// when the test finishes.
+func StartFakeBackend(t testing.TB, handler func(conn net.Conn)) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func(conn net.Conn) {
+				defer conn.Close()
+				handler(conn)
+				// Discard everything the client sends until it disconnects.
+				_, _ = io.Copy(io.Discard, conn)
+			}(conn)
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+// EncodePgMessages encodes the messages into a single buffer.
+func EncodePgMessages(t testing.TB, messages ...pgproto3.Message) []byte {
+	t.Helper()
+
+	var buffer []byte
+	for _, message := range messages {
+		var err error
+		buffer, err = message.Encode(buffer)
+		require.NoError(t, err)
+	}
+
+	return buffer
+}
+
+// NewTestClient creates a client that connects to the server on the given address.
+// The client stops receiving after a deadline, so that a broken test doesn't hang.
+// The chunk size is larger than the responses in the tests, so that Receive returns
+// as soon as it reads what is available.
+func NewTestClient(t testing.TB, address string) *Client {
+	t.Helper()
+
+	client := NewClient(
+		context.Background(),
+		&config.Client{
+			Network:          "tcp",
+			Address:          address,
+			ReceiveChunkSize: 1 << 20,
+			ReceiveDeadline:  10 * time.Second,
+			DialTimeout:      config.DefaultDialTimeout,
+		},
+		zerolog.Nop(),
+		nil)
+	require.NotNil(t, client)
+	t.Cleanup(client.Close)
+
+	return client
+}
+
+// NewTestServer creates a server listening on the given address and a proxy with
+// a pool of the given size connected to the backend, without any plugins.
+func NewTestServer(
+	t testing.TB, address, backend string, poolSize int,
+) (*Server, *Proxy, *plugin.Registry) {
+	t.Helper()
+
+	// Reset prometheus metrics.
+	prometheus.DefaultRegisterer = prometheus.NewRegistry()
+
+	logger := zerolog.New(io.Discard)
+
+	actRegistry := act.NewActRegistry(
+		act.Registry{
+			Signals:              act.BuiltinSignals(),
+			Policies:             act.BuiltinPolicies(),
+			Actions:              act.BuiltinActions(),
+			DefaultPolicyName:    config.DefaultPolicy,
+			PolicyTimeout:        config.DefaultPolicyTimeout,
+			DefaultActionTimeout: config.DefaultActionTimeout,
+			Logger:               logger,
+		})
+	pluginRegistry := plugin.NewRegistry(
+		context.Background(),
+		plugin.Registry{
+			ActRegistry:   actRegistry,
+			Compatibility: config.Loose,
+			Logger:        logger,
+		})
+
+	clientConfig := config.Client{
+		Network:            "tcp",
+		Address:            backend,
+		ReceiveChunkSize:   config.DefaultChunkSize,
+		ReceiveDeadline:    config.DefaultReceiveDeadline,
+		SendDeadline:       config.DefaultSendDeadline,
+		DialTimeout:        config.DefaultDialTimeout,
+		TCPKeepAlive:       false,
+		TCPKeepAlivePeriod: config.DefaultTCPKeepAlivePeriod,
+	}
+
+	newPool := pool.NewPool(context.Background(), poolSize)
+	for range poolSize {
+		client := NewClient(context.Background(), &clientConfig, logger, nil)
+		require.NotNil(t, client)
+		require.Nil(t, newPool.Put(client.ID, client))
+	}
+
+	proxy := NewProxy(
+		context.Background(),
+		Proxy{
+			AvailableConnections: newPool,
+			PluginRegistry:       pluginRegistry,
+			HealthCheckPeriod:    config.DefaultHealthCheckPeriod,
+			ClientConfig:         &clientConfig,
+			Logger:               logger,
+			PluginTimeout:        config.DefaultPluginTimeout,
+		},
+	)
+
+	server := NewServer(
+		context.Background(),
+		Server{
+			Network:          "tcp",
+			Address:          address,
+			TickInterval:     config.DefaultTickInterval,
+			Proxy:            proxy,
+			Logger:           logger,
+			PluginRegistry:   pluginRegistry,
+			PluginTimeout:    config.DefaultPluginTimeout,
+			HandshakeTimeout: config.DefaultHandshakeTimeout,
+		},
+	)
+	require.NotNil(t, server)
+
+	return server, proxy, pluginRegistry
+}
+
+// RunTestServer runs the server in the background and waits until it accepts
+// connections. The server is shut down when the test finishes.
+func RunTestServer(t testing.TB, server *Server, pluginRegistry *plugin.Registry) {
+	t.Helper()
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		if err := server.Run(); err != nil {
+			t.Errorf("server.Run() error = %v", err)
+		}
+	}()
+	t.Cleanup(func() {
+		// Wait for the server connections to be recycled before shutting down.
+		if proxy, ok := server.Proxy.(*Proxy); ok {
+			assert.Eventually(t, func() bool {
+				return proxy.AvailableConnections.Size() == proxy.AvailableConnections.Cap()
+			}, 5*time.Second, 10*time.Millisecond)
+		}
+		server.Shutdown()
+		pluginRegistry.Shutdown()
+		<-stopped
+	})
+
+	require.Eventually(t, server.running.Load, 5*time.Second, 10*time.Millisecond)
+}
+
 func CollectAndComparePrometheusMetrics(t *testing.T) {
 	t.Helper()
 
@@ -0,0 +1,351 @@
+package network
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// CopyIn is the direction of COPY ... FROM STDIN, in which the client sends the data.
+	CopyIn = "in"
+	// CopyOut is the direction of COPY ... TO STDOUT, in which the server sends the data.
+	CopyOut = "out"
+
+	// OnCopyHook is the name of the custom hook that receives the summary of
+	// a COPY operation through the OnHook hooks.
+	OnCopyHook = "onCopy"
+)
+
+// These are the types of the PostgreSQL messages that are used to follow the COPY
+// sub-protocol and the transaction status of the sessions:
+// https://www.postgresql.org/docs/current/protocol-flow.html
+const (
+	copyInResponse  byte = 'G'
+	copyOutResponse byte = 'H'
+	copyData        byte = 'd'
+	copyFail        byte = 'f'
+	commandComplete byte = 'C'
+	errorResponse   byte = 'E'
+	readyForQuery   byte = 'Z'
+
+	// These are the messages that the server may send at any time, without a request.
+	noticeResponse       byte = 'N'
+	notificationResponse byte = 'A'
+	parameterStatus      byte = 'S'
+
+	// These are the transaction statuses of the ReadyForQuery message.
+	txIdle          byte = 'I'
+	txInTransaction byte = 'T'
+	txFailed        byte = 'E'
+	// txUnknown is the transaction status of the sessions whose traffic was relayed,
+	// until the server sends the next ReadyForQuery message.
+	txUnknown byte = 0
+
+	// protocolVersion3 is the version of the protocol in the StartupMessage.
+	protocolVersion3 = 196608
+
+	// messageHeaderSize is the size of the type and the length of a message.
+	messageHeaderSize = 5
+	// messageLengthSize is the size of the length of a message, which is included in the length.
+	messageLengthSize = 4
+)
+
+// messageScanner walks over the messages of a PostgreSQL stream that arrives in chunks
+// of arbitrary size, without buffering the messages. Only the bodies of the messages
+// accepted by the keep function are collected, so they should be small.
+type messageScanner struct {
+	header    [messageHeaderSize]byte
+	headerLen int
+	remaining int
+	body      []byte
+	keep      func(typ byte) bool
+}
+
+// Scan calls onMessage for every message that ends in the chunk with the type and the size
+// of the message body. The body is only passed if the message is kept, otherwise it is nil.
+func (s *messageScanner) Scan(chunk []byte, onMessage func(typ byte, size int, body []byte)) {
+	for len(chunk) > 0 {
+		if s.headerLen < messageHeaderSize {
+			read := copy(s.header[s.headerLen:], chunk)
+			s.headerLen += read
+			chunk = chunk[read:]
+			if s.headerLen < messageHeaderSize {
+				return
+			}
+			s.remaining = max(s.size(), 0)
+			s.body = s.body[:0]
+		}
+
+		read := min(s.remaining, len(chunk))
+		kept := s.keep != nil && s.keep(s.header[0])
+		if kept {
+			s.body = append(s.body, chunk[:read]...)
+		}
+		s.remaining -= read
+		chunk = chunk[read:]
+
+		if s.remaining == 0 {
+			s.headerLen = 0
+			if kept {
+				onMessage(s.header[0], s.size(), s.body)
+			} else {
+				onMessage(s.header[0], s.size(), nil)
+			}
+		}
+	}
+}
+
+// Reset discards the partially scanned message.
+func (s *messageScanner) Reset() {
+	s.headerLen = 0
+	s.remaining = 0
+	s.body = s.body[:0]
+}
+
+// size returns the size of the body of the current message.
+func (s *messageScanner) size() int {
+	return int(binary.BigEndian.Uint32(s.header[1:])) - messageLengthSize
+}
+
+// CopySummary is the summary of a COPY operation, which is sent to the plugins
+// when the operation ends.
+type CopySummary struct {
+	Direction string
+	Rows      int
+	Bytes     int
+	Messages  int
+	Duration  time.Duration
+	Failed    bool
+}
+
+// protocolState follows the protocol on a client connection. It tells the proxy when
+// the data of a COPY operation is streamed and counts the data that is copied. It also
+// tells whether the session waits for the client and in which transaction status.
+type protocolState struct {
+	mu        sync.Mutex
+	direction string
+	started   time.Time
+	bytes     int
+	messages  int
+	failed    bool
+
+	txStatus byte
+	// waitingSince is the time since which the server waits for the client,
+	// or zero if the client sent traffic that the server hasn't answered yet.
+	waitingSince time.Time
+
+	server messageScanner
+	client messageScanner
+}
+
+// newProtocolState creates a new protocol state for a client connection.
+func newProtocolState() *protocolState {
+	return &protocolState{
+		server: messageScanner{
+			// The command tag contains the number of copied rows and
+			// the ReadyForQuery message contains the transaction status.
+			keep: func(typ byte) bool { return typ == commandComplete || typ == readyForQuery },
+		},
+		txStatus: txIdle,
+	}
+}
+
+// CopyDirection returns the direction of the COPY operation in progress,
+// or an empty string if there is none.
+func (cs *protocolState) CopyDirection() string {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	return cs.direction
+}
+
+// Waiting returns the time since which the server waits for the client and the transaction
+// status of the session. The time is zero if the server hasn't answered the client yet.
+func (cs *protocolState) Waiting() (time.Time, byte) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	return cs.waitingSince, cs.txStatus
+}
+
+// ClientActive records that the client sent traffic to the server.
+func (cs *protocolState) ClientActive() {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	cs.waitingSince = time.Time{}
+}
+
+// ResetServer forgets what is known about the traffic from the server, after the traffic
+// is relayed without being scanned. The transaction status is unknown until the server
+// sends the next ReadyForQuery message.
+func (cs *protocolState) ResetServer() {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	cs.server.Reset()
+	cs.direction = ""
+	cs.txStatus = txUnknown
+	cs.waitingSince = time.Time{}
+}
+
+// ResetClient forgets what is known about the traffic from the client, after the traffic
+// is relayed without being scanned.
+func (cs *protocolState) ResetClient() {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	cs.client.Reset()
+}
+
+// ScanServer scans the traffic from the server. It returns the summary of
+// the COPY operation if the operation ended in the traffic, otherwise nil.
+func (cs *protocolState) ScanServer(traffic []byte) *CopySummary {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	var summary *CopySummary
+	cs.server.Scan(traffic, func(typ byte, size int, body []byte) {
+		switch typ {
+		case copyInResponse:
+			cs.start(CopyIn)
+		case copyOutResponse:
+			cs.start(CopyOut)
+		case copyData:
+			if cs.direction == CopyOut {
+				cs.bytes += size
+				cs.messages++
+			}
+		case errorResponse:
+			if cs.direction != "" {
+				cs.failed = true
+				summary = cs.end(0)
+			}
+		case commandComplete:
+			if cs.direction != "" {
+				summary = cs.end(copiedRows(body))
+			}
+		case readyForQuery:
+			if cs.direction != "" {
+				summary = cs.end(0)
+			}
+			if len(body) > 0 {
+				cs.txStatus = body[0]
+			}
+			cs.waitingSince = time.Now()
+		}
+	})
+
+	return summary
+}
+
+// ScanClient scans the traffic from the client while the client sends the data
+// of COPY ... FROM STDIN.
+func (cs *protocolState) ScanClient(traffic []byte) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	cs.waitingSince = time.Time{}
+	cs.client.Scan(traffic, func(typ byte, size int, _ []byte) {
+		switch typ {
+		case copyData:
+			cs.bytes += size
+			cs.messages++
+		case copyFail:
+			cs.failed = true
+		}
+	})
+}
+
+// start starts a COPY operation in the given direction.
+func (cs *protocolState) start(direction string) {
+	cs.direction = direction
+	cs.started = time.Now()
+	cs.bytes = 0
+	cs.messages = 0
+	cs.failed = false
+	// The client sends the data after it receives the CopyInResponse,
+	// so the data starts at the beginning of a message.
+	cs.client.Reset()
+}
+
+// end ends the COPY operation in progress and returns its summary.
+func (cs *protocolState) end(rows int) *CopySummary {
+	summary := &CopySummary{
+		Direction: cs.direction,
+		Rows:      rows,
+		Bytes:     cs.bytes,
+		Messages:  cs.messages,
+		Duration:  time.Since(cs.started),
+		Failed:    cs.failed,
+	}
+	cs.direction = ""
+	return summary
+}
+
+// copiedRows returns the number of rows from the command tag of
+// a CommandComplete message, e.g. "COPY 42".
+func copiedRows(tag []byte) int {
+	tag = bytes.TrimRight(tag, "\x00")
+	if !bytes.HasPrefix(tag, []byte("COPY ")) {
+		return 0
+	}
+
+	rows, err := strconv.Atoi(string(tag[len("COPY "):]))
+	if err != nil {
+		return 0
+	}
+
+	return rows
+}
+
+// isAsynchronous returns true if the response only consists of the messages that the
+// server sends without a request from the client, like notifications, so that they
+// aren't paired with the request that still waits for its response:
+// https://www.postgresql.org/docs/current/protocol-flow.html#PROTOCOL-ASYNC
+func isAsynchronous(response []byte) bool {
+	if len(response) == 0 {
+		return false
+	}
+
+	for len(response) > 0 {
+		if len(response) < messageHeaderSize {
+			return false
+		}
+		switch response[0] {
+		case noticeResponse, notificationResponse, parameterStatus:
+		default:
+			return false
+		}
+
+		length := int(binary.BigEndian.Uint32(response[1:messageHeaderSize]))
+		if length < messageLengthSize || 1+length > len(response) {
+			return false
+		}
+		response = response[1+length:]
+	}
+
+	return true
+}
+
+// startupParameters returns the parameters of a StartupMessage, e.g. the user and the
+// database, or nil if the request isn't a StartupMessage of the protocol version 3.0.
+func startupParameters(request []byte) map[string]string {
+	if len(request) < 2*messageLengthSize ||
+		int(binary.BigEndian.Uint32(request)) != len(request) ||
+		binary.BigEndian.Uint32(request[messageLengthSize:]) != protocolVersion3 {
+		return nil
+	}
+
+	parameters := make(map[string]string)
+	fields := bytes.Split(request[2*messageLengthSize:], []byte{0})
+	// The parameters are pairs of names and values, terminated by an empty name.
+	for i := 0; i+1 < len(fields) && len(fields[i]) > 0; i += 2 {
+		parameters[string(fields[i])] = string(fields[i+1])
+	}
+
+	return parameters
+}
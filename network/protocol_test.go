@@ -0,0 +1,266 @@
+package network
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin/v1"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// TestMessageScanner tests that the messages are found regardless of how the stream is chunked.
+func TestMessageScanner(t *testing.T) {
+	stream := EncodePgMessages(t,
+		&pgproto3.CopyData{Data: []byte("1\tone\n")},
+		&pgproto3.CopyData{Data: []byte("2\ttwo\n")},
+		&pgproto3.CopyDone{},
+		&pgproto3.CommandComplete{CommandTag: []byte("COPY 2")},
+	)
+
+	for _, chunkSize := range []int{1, 3, 5, 7, len(stream)} {
+		scanner := messageScanner{
+			keep: func(typ byte) bool { return typ == commandComplete },
+		}
+
+		var types []byte
+		var sizes []int
+		var tag []byte
+		for start := 0; start < len(stream); start += chunkSize {
+			end := min(start+chunkSize, len(stream))
+			scanner.Scan(stream[start:end], func(typ byte, size int, body []byte) {
+				types = append(types, typ)
+				sizes = append(sizes, size)
+				if typ == commandComplete {
+					tag = append([]byte{}, body...)
+				}
+			})
+		}
+
+		assert.Equal(t, []byte{'d', 'd', 'c', 'C'}, types, "chunk size %d", chunkSize)
+		assert.Equal(t, []int{6, 6, 0, 7}, sizes, "chunk size %d", chunkSize)
+		assert.Equal(t, 2, copiedRows(tag), "chunk size %d", chunkSize)
+	}
+}
+
+// TestProtocolState tests following the COPY operations in both directions.
+func TestProtocolState(t *testing.T) {
+	state := newProtocolState()
+
+	// COPY ... TO STDOUT.
+	assert.Nil(t, state.ScanServer(EncodePgMessages(t,
+		&pgproto3.CopyOutResponse{ColumnFormatCodes: []uint16{0}},
+		&pgproto3.CopyData{Data: []byte("1\n")},
+	)))
+	assert.Equal(t, CopyOut, state.CopyDirection())
+	summary := state.ScanServer(EncodePgMessages(t,
+		&pgproto3.CopyData{Data: []byte("2\n")},
+		&pgproto3.CopyDone{},
+		&pgproto3.CommandComplete{CommandTag: []byte("COPY 2")},
+		&pgproto3.ReadyForQuery{TxStatus: 'I'},
+	))
+	require.NotNil(t, summary)
+	assert.Equal(t, CopyOut, summary.Direction)
+	assert.Equal(t, 2, summary.Rows)
+	assert.Equal(t, 4, summary.Bytes)
+	assert.Equal(t, 2, summary.Messages)
+	assert.False(t, summary.Failed)
+	assert.Empty(t, state.CopyDirection())
+
+	// COPY ... FROM STDIN that the client cancels.
+	assert.Nil(t, state.ScanServer(EncodePgMessages(t,
+		&pgproto3.CopyInResponse{ColumnFormatCodes: []uint16{0}},
+	)))
+	assert.Equal(t, CopyIn, state.CopyDirection())
+	state.ScanClient(EncodePgMessages(t,
+		&pgproto3.CopyData{Data: []byte("abc\n")},
+		&pgproto3.CopyFail{Message: "canceled"},
+	))
+	summary = state.ScanServer(EncodePgMessages(t,
+		&pgproto3.ErrorResponse{Severity: "ERROR", Code: "57014", Message: "canceled"},
+		&pgproto3.ReadyForQuery{TxStatus: 'I'},
+	))
+	require.NotNil(t, summary)
+	assert.Equal(t, CopyIn, summary.Direction)
+	assert.Zero(t, summary.Rows)
+	assert.Equal(t, 4, summary.Bytes)
+	assert.Equal(t, 1, summary.Messages)
+	assert.True(t, summary.Failed)
+	assert.Empty(t, state.CopyDirection())
+}
+
+// TestIsAsynchronous tests telling the messages that the server sends on its own
+// from the responses to the requests.
+func TestIsAsynchronous(t *testing.T) {
+	notification := EncodePgMessages(t,
+		&pgproto3.NotificationResponse{PID: 1, Channel: "events", Payload: "hello"},
+		&pgproto3.ParameterStatus{Name: "TimeZone", Value: "UTC"},
+		&pgproto3.NoticeResponse{Severity: "NOTICE", Message: "hello"},
+	)
+	assert.True(t, isAsynchronous(notification))
+
+	response := EncodePgMessages(t,
+		&pgproto3.NotificationResponse{PID: 1, Channel: "events", Payload: "hello"},
+		&pgproto3.ReadyForQuery{TxStatus: 'I'},
+	)
+	assert.False(t, isAsynchronous(response))
+	assert.False(t, isAsynchronous(notification[:len(notification)-1]))
+	assert.False(t, isAsynchronous(nil))
+}
+
+// TestProxyCopy tests streaming the data of COPY operations through the proxy.
+func TestProxyCopy(t *testing.T) {
+	rows := [][]byte{[]byte("1\tone\n"), []byte("2\ttwo\n"), []byte("3\tthree\n")}
+
+	tests := []struct {
+		name          string
+		direction     string
+		skipCopyHooks bool
+	}{
+		{name: "copy out", direction: CopyOut, skipCopyHooks: false},
+		{name: "copy out without hooks", direction: CopyOut, skipCopyHooks: true},
+		{name: "copy in", direction: CopyIn, skipCopyHooks: false},
+		{name: "copy in without hooks", direction: CopyIn, skipCopyHooks: true},
+	}
+
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backend := StartFakeBackend(t, func(conn net.Conn) {
+				buffer := make([]byte, 1024)
+				// Wait for the query.
+				if _, err := conn.Read(buffer); err != nil {
+					return
+				}
+
+				if test.direction == CopyOut {
+					// Send the messages separately, so that the data is streamed.
+					messages := []pgproto3.Message{
+						&pgproto3.CopyOutResponse{ColumnFormatCodes: []uint16{0, 0}},
+					}
+					for _, row := range rows {
+						messages = append(messages, &pgproto3.CopyData{Data: row})
+					}
+					messages = append(messages, &pgproto3.CopyDone{})
+					for _, message := range messages {
+						_, _ = conn.Write(EncodePgMessages(t, message))
+						time.Sleep(10 * time.Millisecond)
+					}
+					_, _ = conn.Write(EncodePgMessages(t,
+						&pgproto3.CommandComplete{CommandTag: []byte("COPY 3")},
+						&pgproto3.ReadyForQuery{TxStatus: 'I'},
+					))
+					return
+				}
+
+				_, _ = conn.Write(EncodePgMessages(t,
+					&pgproto3.CopyInResponse{ColumnFormatCodes: []uint16{0, 0}}))
+				// Wait for the CopyDone message.
+				var scanner messageScanner
+				done := false
+				for !done {
+					read, err := conn.Read(buffer)
+					if err != nil {
+						return
+					}
+					scanner.Scan(buffer[:read], func(typ byte, _ int, _ []byte) {
+						done = done || typ == 'c'
+					})
+				}
+				_, _ = conn.Write(EncodePgMessages(t,
+					&pgproto3.CommandComplete{CommandTag: []byte("COPY 3")},
+					&pgproto3.ReadyForQuery{TxStatus: 'I'},
+				))
+			})
+
+			address := "127.0.0.1:" + []string{"15434", "15435", "15436", "15437"}[i]
+			server, proxy, pluginRegistry := NewTestServer(t, address, backend, 1)
+			proxy.SkipCopyHooks = test.skipCopyHooks
+
+			var trafficHooks atomic.Int32
+			countTraffic := func(
+				_ context.Context, params *v1.Struct, _ ...grpc.CallOption,
+			) (*v1.Struct, error) {
+				trafficHooks.Add(1)
+				return params, nil
+			}
+			pluginRegistry.AddHook(v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT, 1, countTraffic)
+			pluginRegistry.AddHook(v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_SERVER, 1, countTraffic)
+
+			summaries := make(chan map[string]interface{}, 1)
+			pluginRegistry.AddHook(v1.HookName_HOOK_NAME_ON_HOOK, 1, func(
+				_ context.Context, params *v1.Struct, _ ...grpc.CallOption,
+			) (*v1.Struct, error) {
+				summaries <- params.AsMap()
+				return params, nil
+			})
+
+			RunTestServer(t, server, pluginRegistry)
+			client := NewTestClient(t, address)
+
+			_, err := client.Send(EncodePgMessages(t, &pgproto3.Query{String: "COPY test"}))
+			require.Nil(t, err)
+
+			var received []byte
+			if test.direction == CopyIn {
+				// Wait for the CopyInResponse and send the data.
+				_, data, err := client.Receive()
+				require.Nil(t, err)
+				assert.Equal(t, copyInResponse, data[0])
+
+				for _, row := range rows {
+					_, err := client.Send(EncodePgMessages(t, &pgproto3.CopyData{Data: row}))
+					require.Nil(t, err)
+				}
+				_, err = client.Send(EncodePgMessages(t, &pgproto3.CopyDone{}))
+				require.Nil(t, err)
+			}
+
+			// Receive everything until the ReadyForQuery message.
+			for len(received) < 6 || received[len(received)-6] != readyForQuery {
+				_, data, err := client.Receive()
+				require.Nil(t, err)
+				received = append(received, data...)
+			}
+
+			select {
+			case summary := <-summaries:
+				assert.Equal(t, OnCopyHook, summary["hook"])
+				assert.Equal(t, test.direction, summary["direction"])
+				assert.InEpsilon(t, 3, summary["rows"], 0)
+				assert.InEpsilon(t, 20, summary["bytes"], 0)
+				assert.InEpsilon(t, 3, summary["messages"], 0)
+				assert.Equal(t, false, summary["failed"])
+			case <-time.After(5 * time.Second):
+				t.Fatal("The onCopy hook was not run")
+			}
+
+			// The hooks run for the query and the responses that aren't COPY data.
+			expectedHooks := map[string]int32{CopyOut: 2, CopyIn: 3}[test.direction]
+			if test.skipCopyHooks {
+				assert.Equal(t, expectedHooks, trafficHooks.Load())
+			} else {
+				assert.Greater(t, trafficHooks.Load(), expectedHooks)
+			}
+			assert.Empty(t, conn(t, proxy).session.protocol.CopyDirection())
+		})
+	}
+}
+
+// conn returns the only client connection of the proxy.
+func conn(t *testing.T, proxy *Proxy) *ConnWrapper {
+	t.Helper()
+
+	var connWrapper *ConnWrapper
+	proxy.busyConnections.ForEach(func(key, _ interface{}) bool {
+		connWrapper, _ = key.(*ConnWrapper)
+		return false
+	})
+	require.NotNil(t, connWrapper)
+
+	return connWrapper
+}
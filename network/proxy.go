@@ -2,11 +2,17 @@ package network
 
 import (
 	"bytes"
+	"cmp"
 	"context"
+	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"net"
+	"os"
 	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	sdkAct "github.com/gatewayd-io/gatewayd-plugin-sdk/act"
@@ -14,17 +20,27 @@ import (
 	"github.com/gatewayd-io/gatewayd/act"
 	"github.com/gatewayd-io/gatewayd/config"
 	gerr "github.com/gatewayd-io/gatewayd/errors"
+	"github.com/gatewayd-io/gatewayd/events"
 	"github.com/gatewayd-io/gatewayd/metrics"
 	"github.com/gatewayd-io/gatewayd/plugin"
 	"github.com/gatewayd-io/gatewayd/pool"
 	"github.com/getsentry/sentry-go"
 	"github.com/go-co-op/gocron"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cast"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/exp/maps"
 )
 
+// backendCheckInterval is how long the result of checking the database server is reused,
+// so that frequent readiness probes don't open a connection to the server each time.
+const backendCheckInterval = time.Second
+
+// relayCheckInterval is how long the relay waits for the traffic before checking whether
+// the connection can still be relayed, e.g. after the hooks are registered at runtime.
+const relayCheckInterval = time.Second
+
 type IProxy interface {
 	Connect(conn *ConnWrapper) *gerr.GatewayDError
 	Disconnect(conn *ConnWrapper) *gerr.GatewayDError
@@ -32,9 +48,18 @@ type IProxy interface {
 	PassThroughToClient(conn *ConnWrapper, stack *Stack) *gerr.GatewayDError
 	IsHealthy(cl *Client) (*Client, *gerr.GatewayDError)
 	IsExhausted() bool
+	PoolUsage() (int, int)
+	DialBackend(timeout time.Duration) *gerr.GatewayDError
 	Shutdown()
 	AvailableConnectionsString() []string
 	BusyConnectionsString() []string
+	Connections() []ConnectionInfo
+	KillConnection(id uint64) *gerr.GatewayDError
+	Pause()
+	Resume()
+	IsPaused() bool
+	Reconnect() *gerr.GatewayDError
+	Resize(size int) *gerr.GatewayDError
 }
 
 type Proxy struct {
@@ -46,9 +71,24 @@ type Proxy struct {
 	ctx                  context.Context //nolint:containedctx
 	PluginTimeout        time.Duration
 	HealthCheckPeriod    time.Duration
+	// SkipCopyHooks skips the traffic hooks for the data streamed by COPY operations.
+	SkipCopyHooks bool
+
+	// resumed is not nil while the proxy is paused and it is closed when the proxy resumes.
+	resumed chan struct{}
+	pauseMu *sync.Mutex
+
+	// backendCheckedAt and backendCheckErr are the time and the result of the last
+	// check of the database server, which are reused for backendCheckInterval.
+	backendCheckMu   *sync.Mutex
+	backendCheckedAt time.Time
+	backendCheckErr  *gerr.GatewayDError
 
 	// ClientConfig is used for reconnection
 	ClientConfig *config.Client
+
+	// EventBus receives the pool exhausted and backend reconnect failed events.
+	EventBus *events.Bus
 }
 
 var _ IProxy = (*Proxy)(nil)
@@ -70,7 +110,11 @@ func NewProxy(
 		ctx:                  proxyCtx,
 		PluginTimeout:        pxy.PluginTimeout,
 		ClientConfig:         pxy.ClientConfig,
+		pauseMu:              &sync.Mutex{},
+		backendCheckMu:       &sync.Mutex{},
 		HealthCheckPeriod:    pxy.HealthCheckPeriod,
+		SkipCopyHooks:        pxy.SkipCopyHooks,
+		EventBus:             pxy.EventBus,
 	}
 
 	startDelay := time.Now().Add(proxy.HealthCheckPeriod)
@@ -155,6 +199,11 @@ func (pr *Proxy) Connect(conn *ConnWrapper) *gerr.GatewayDError {
 	if pr.IsExhausted() {
 		// Pool is exhausted
 		span.AddEvent(gerr.ErrPoolExhausted.Error())
+		pr.EventBus.Publish(events.PoolExhausted, "proxy", map[string]interface{}{
+			"client": RemoteAddr(conn.Conn()),
+			"size":   pr.AvailableConnections.Cap(),
+			"busy":   pr.busyConnections.Size(),
+		})
 		return gerr.ErrPoolExhausted
 	}
 	// Get the client from the pool with the given clientID.
@@ -218,10 +267,21 @@ func (pr *Proxy) Disconnect(conn *ConnWrapper) *gerr.GatewayDError {
 	}
 
 	if client, ok := client.(*Client); ok {
+		// Close the server connection if the pool has been shrunk.
+		if capacity := pr.AvailableConnections.Cap(); capacity > 0 &&
+			pr.AvailableConnections.Size()+pr.busyConnections.Size() >= capacity {
+			client.Close()
+			metrics.ProxiedConnections.Dec()
+			pr.Logger.Debug().Str("function", "proxy.disconnect").Msg(
+				"Closed the client connection, because the pool is full")
+			return nil
+		}
+
 		// Recycle the server connection by reconnecting.
 		if err := client.Reconnect(); err != nil {
 			pr.Logger.Error().Err(err).Msg("Failed to reconnect to the client")
 			span.RecordError(err)
+			pr.publishReconnectFailed(client, err)
 		}
 
 		// If the client is not in the pool, put it back.
@@ -280,9 +340,37 @@ func (pr *Proxy) PassThroughToServer(conn *ConnWrapper, stack *Stack) *gerr.Gate
 		return gerr.ErrClientNotConnected
 	}
 
-	// Receive the request from the client.
-	request, origErr := pr.receiveTrafficFromClient(conn.Conn())
+	// Relay the traffic as is if nothing needs to see it. Once the relay is left,
+	// the traffic is passed through the hooks again.
+	if pr.canRelay(conn) {
+		return pr.relay(
+			conn, client.conn, conn.Conn(), metrics.BytesReceivedFromClient,
+			metrics.BytesSentToServer, &conn.session.bytesIn,
+			conn.session.protocol.ResetClient)
+	}
+
+	// Receive the request from the client. The data of COPY ... FROM STDIN is
+	// streamed one chunk at a time, so that the memory usage is bounded.
+	request, origErr := pr.receiveTrafficFromClient(
+		conn.Conn(), conn.session.protocol.CopyDirection() == CopyIn)
 	span.AddEvent("Received traffic from client")
+	conn.session.bytesIn.Add(int64(len(request)))
+
+	// The COPY operation might have started while waiting for the client.
+	if conn.session.protocol.CopyDirection() == CopyIn {
+		return pr.streamToServer(conn, client, request, origErr)
+	}
+
+	// The session is no longer idle until the server answers the client. If the proxy
+	// is paused, the request waits until the proxy resumes, unless it is in a transaction.
+	atTransactionBoundary := conn.session.AtTransactionBoundary()
+	conn.session.protocol.ClientActive()
+	if atTransactionBoundary {
+		if err := pr.waitIfPaused(conn); err != nil {
+			span.RecordError(err)
+			return err
+		}
+	}
 
 	// Run the OnTrafficFromClient hooks.
 	pluginTimeoutCtx, cancel := context.WithTimeout(context.Background(), pr.PluginTimeout)
@@ -311,6 +399,10 @@ func (pr *Proxy) PassThroughToServer(conn *ConnWrapper, stack *Stack) *gerr.Gate
 		// Client closed the connection.
 		span.AddEvent("Client closed the connection")
 		return gerr.ErrClientNotConnected.Wrap(origErr)
+	} else if origErr != nil && errors.Is(origErr, net.ErrClosed) {
+		// The connection was closed on this side, e.g. it was killed.
+		span.AddEvent("Connection is closed")
+		return origErr
 	}
 
 	// Check if the client sent a SSL request and the server supports SSL.
@@ -385,6 +477,15 @@ func (pr *Proxy) PassThroughToServer(conn *ConnWrapper, stack *Stack) *gerr.Gate
 		return nil
 	}
 
+	// The first request after the SSL negotiation is the startup message.
+	if !conn.session.startupSent.Swap(true) {
+		conn.session.Started(request)
+		// The admin console answers the client itself, instead of the server.
+		if conn.session.IsAdmin() {
+			return pr.serveAdminConsole(conn)
+		}
+	}
+
 	// Push the client's request to the stack.
 	stack.Push(&Request{Data: request})
 
@@ -413,7 +514,7 @@ func (pr *Proxy) PassThroughToServer(conn *ConnWrapper, stack *Stack) *gerr.Gate
 			// Remove the request from the stack if the response is modified.
 			stack.PopLastRequest()
 
-			return pr.sendTrafficToClient(conn.Conn(), modResponse, modReceived)
+			return pr.sendTrafficToClient(conn, modResponse, modReceived)
 		}
 		span.RecordError(gerr.ErrHookTerminatedConnection)
 		return gerr.ErrHookTerminatedConnection
@@ -450,6 +551,10 @@ func (pr *Proxy) PassThroughToServer(conn *ConnWrapper, stack *Stack) *gerr.Gate
 	if err != nil {
 		pr.Logger.Error().Err(err).Msg("Error running hook")
 		span.RecordError(err)
+		if errors.Is(err, gerr.ErrPluginFailedClosed) {
+			// The pump closes the connection when it receives the error.
+			return err
+		}
 	}
 	span.AddEvent("Ran the OnTrafficToServer hooks")
 
@@ -483,8 +588,19 @@ func (pr *Proxy) PassThroughToClient(conn *ConnWrapper, stack *Stack) *gerr.Gate
 		return gerr.ErrClientNotConnected
 	}
 
-	// Receive the response from the server.
-	received, response, err := pr.receiveTrafficFromServer(client)
+	// Relay the traffic as is if nothing needs to see it. Once the relay is left,
+	// the traffic is passed through the hooks again.
+	if pr.canRelay(conn) {
+		return pr.relay(
+			conn, conn, client.conn, metrics.BytesReceivedFromServer,
+			metrics.BytesSentToClient, &conn.session.bytesOut,
+			conn.session.protocol.ResetServer)
+	}
+
+	// Receive the response from the server. The data of COPY ... TO STDOUT is
+	// streamed one chunk at a time, so that the memory usage is bounded.
+	streaming := conn.session.protocol.CopyDirection() == CopyOut
+	received, response, err := pr.receiveTrafficFromServer(client, streaming)
 	span.AddEvent("Received traffic from server")
 
 	// If the response is empty, don't send anything, instead just close the ingress connection.
@@ -505,14 +621,32 @@ func (pr *Proxy) PassThroughToClient(conn *ConnWrapper, stack *Stack) *gerr.Gate
 		return err
 	}
 
+	conn.session.bytesOut.Add(int64(received))
+
+	// Follow the COPY operations and report them to the plugins once they end,
+	// after the response is sent to the client.
+	if summary := conn.session.protocol.ScanServer(response[:received]); summary != nil {
+		defer pr.runCopyHooks(conn, client, summary)
+	}
+
+	if streaming && pr.SkipCopyHooks {
+		// Stream the data to the client without running the traffic hooks.
+		span.AddEvent("Streaming COPY data to client")
+		metrics.ProxyPassThroughsToClient.Inc()
+		return pr.sendTrafficToClient(conn, response, received)
+	}
+
 	pluginTimeoutCtx, cancel := context.WithTimeout(context.Background(), pr.PluginTimeout)
 	defer cancel()
 
-	// Get the last request from the stack.
-	lastRequest := stack.PopLastRequest()
+	// Get the last request from the stack. The messages that the server sends on its
+	// own, like notifications, are passed with an empty request, so that the request
+	// is kept for its response.
 	request := make([]byte, 0)
-	if lastRequest != nil {
-		request = lastRequest.Data
+	if !isAsynchronous(response[:received]) {
+		if lastRequest := stack.PopLastRequest(); lastRequest != nil {
+			request = lastRequest.Data
+		}
 	}
 
 	// Run the OnTrafficFromServer hooks.
@@ -536,6 +670,10 @@ func (pr *Proxy) PassThroughToClient(conn *ConnWrapper, stack *Stack) *gerr.Gate
 	if err != nil {
 		pr.Logger.Error().Err(err).Msg("Error running hook")
 		span.RecordError(err)
+		if errors.Is(err, gerr.ErrPluginFailedClosed) {
+			// The response isn't sent to the client.
+			return err
+		}
 	}
 	span.AddEvent("Ran the OnTrafficFromServer hooks")
 
@@ -547,7 +685,7 @@ func (pr *Proxy) PassThroughToClient(conn *ConnWrapper, stack *Stack) *gerr.Gate
 	}
 
 	// Send the response to the client.
-	errVerdict := pr.sendTrafficToClient(conn.Conn(), response, received)
+	errVerdict := pr.sendTrafficToClient(conn, response, received)
 	span.AddEvent("Sent traffic to client")
 
 	// Run the OnTrafficToClient hooks.
@@ -575,6 +713,9 @@ func (pr *Proxy) PassThroughToClient(conn *ConnWrapper, stack *Stack) *gerr.Gate
 	if err != nil {
 		pr.Logger.Error().Err(err).Msg("Error running hook")
 		span.RecordError(err)
+		if errors.Is(err, gerr.ErrPluginFailedClosed) {
+			return err
+		}
 	}
 
 	if errVerdict != nil {
@@ -586,6 +727,229 @@ func (pr *Proxy) PassThroughToClient(conn *ConnWrapper, stack *Stack) *gerr.Gate
 	return errVerdict
 }
 
+// canRelay returns true if the traffic of the connection can be relayed as is, without
+// reading it in chunks. This is the case when no plugin registers any of the hooks that
+// receive the traffic, so there is nothing to pass the traffic to and no policies to
+// apply to the results, and the connection can no longer be upgraded to TLS. The traffic
+// of the sessions with timeouts is never relayed, because the server follows the protocol
+// to tell when the sessions are idle. The relayed connections check this again whenever
+// they are quiet, so that the hooks that are registered later see their traffic, too.
+func (pr *Proxy) canRelay(conn *ConnWrapper) bool {
+	if !conn.session.startupSent.Load() || conn.session.tracked || pr.IsPaused() {
+		return false
+	}
+
+	return !pr.PluginRegistry.HasHooks(
+		v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT,
+		v1.HookName_HOOK_NAME_ON_TRAFFIC_TO_SERVER,
+		v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_SERVER,
+		v1.HookName_HOOK_NAME_ON_TRAFFIC_TO_CLIENT,
+		// The OnHook hooks receive the summaries of the COPY operations.
+		v1.HookName_HOOK_NAME_ON_HOOK,
+	)
+}
+
+// relay copies the traffic from the source to the destination until either side
+// closes the connection, or until the source is quiet for the relay check interval
+// and the connection can no longer be relayed, e.g. because the proxy is paused or
+// a plugin registered the traffic hooks. In the latter case, it calls the reset function
+// to forget the state of the protocol that was relayed, and it returns nil. On Linux,
+// TCP connections without TLS are relayed with splice, so the traffic is not copied
+// to the user space at all. The traffic is copied in steps of the chunk size, so that
+// the relayed bytes are counted while the connection is open.
+func (pr *Proxy) relay(
+	conn *ConnWrapper,
+	dst io.Writer,
+	src net.Conn,
+	received, sent prometheus.Summary,
+	counter *atomic.Int64,
+	reset func(),
+) *gerr.GatewayDError {
+	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "relay")
+	defer span.End()
+
+	conn.session.relayed.Add(1)
+	defer conn.session.relayed.Add(-1)
+	defer func() {
+		// The source has no deadline outside the relay.
+		_ = src.SetReadDeadline(time.Time{})
+	}()
+
+	chunkSize := config.If(
+		pr.ClientConfig.ReceiveChunkSize > 0,
+		pr.ClientConfig.ReceiveChunkSize,
+		config.DefaultChunkSize,
+	)
+	buffer := getBuffer(chunkSize)
+	defer putBuffer(buffer)
+
+	// The source is limited to a step, which still lets the destination splice from it.
+	step := &io.LimitedReader{R: src}
+	for {
+		step.N = int64(chunkSize)
+		if err := src.SetReadDeadline(time.Now().Add(relayCheckInterval)); err != nil {
+			span.RecordError(err)
+			return gerr.ErrRelayFailed.Wrap(err)
+		}
+		// The buffer is only used if neither side supports copying the data directly.
+		written, err := io.CopyBuffer(dst, step, *buffer)
+		if written > 0 {
+			pr.Logger.Trace().Fields(
+				map[string]interface{}{
+					"function": "proxy.relay",
+					"length":   written,
+				},
+			).Msg("Relayed data")
+
+			counter.Add(written)
+			received.Observe(float64(written))
+			sent.Observe(float64(written))
+			metrics.TotalTrafficBytes.Observe(float64(written))
+		}
+
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			// The connection is only left while it's quiet, so that the messages
+			// aren't split between the relay and the hooks.
+			if written == 0 && !pr.canRelay(conn) {
+				reset()
+				span.AddEvent("Left the relay")
+				return nil
+			}
+			continue
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			return gerr.ErrRelayFailed.Wrap(err)
+		}
+
+		if step.N > 0 {
+			// The source closed the connection before the step was copied.
+			return gerr.ErrRelayFailed.Wrap(io.EOF)
+		}
+	}
+}
+
+// streamToServer sends a chunk of the data of COPY ... FROM STDIN to the server. The
+// traffic hooks can modify the chunk, unless they are skipped, but they can't terminate
+// the request, because the server waits for the rest of the data. The connection is
+// closed if a plugin fails closed.
+func (pr *Proxy) streamToServer(
+	conn *ConnWrapper, client *Client, request []byte, origErr *gerr.GatewayDError,
+) *gerr.GatewayDError {
+	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "streamToServer")
+	defer span.End()
+
+	if origErr != nil {
+		span.RecordError(origErr)
+		if errors.Is(origErr, io.EOF) {
+			// Client closed the connection.
+			return gerr.ErrClientNotConnected.Wrap(origErr)
+		}
+		return origErr
+	}
+
+	conn.session.protocol.ScanClient(request)
+
+	if pr.SkipCopyHooks {
+		_, err := pr.sendTrafficToServer(client, request)
+		span.AddEvent("Streamed COPY data to server")
+		metrics.ProxyPassThroughsToServer.Inc()
+		return err
+	}
+
+	// Run the OnTrafficFromClient hooks.
+	pluginTimeoutCtx, cancel := context.WithTimeout(context.Background(), pr.PluginTimeout)
+	defer cancel()
+
+	result, err := pr.PluginRegistry.Run(
+		pluginTimeoutCtx,
+		trafficData(conn.Conn(), client, []Field{{Name: "request", Value: request}}, nil),
+		v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT)
+	if err != nil {
+		pr.Logger.Error().Err(err).Msg("Error running hook")
+		span.RecordError(err)
+		if errors.Is(err, gerr.ErrPluginFailedClosed) {
+			// The chunk isn't sent to the server.
+			return err
+		}
+	}
+
+	// If the hook modified the request, use the modified request.
+	if modRequest := pr.getPluginModifiedRequest(result); modRequest != nil {
+		request = modRequest
+		span.AddEvent("Plugin(s) modified the request")
+	}
+
+	// Send the request to the server.
+	_, sendErr := pr.sendTrafficToServer(client, request)
+	span.AddEvent("Streamed COPY data to server")
+
+	// Run the OnTrafficToServer hooks.
+	pluginTimeoutCtx, cancel = context.WithTimeout(context.Background(), pr.PluginTimeout)
+	defer cancel()
+
+	_, err = pr.PluginRegistry.Run(
+		pluginTimeoutCtx,
+		trafficData(conn.Conn(), client, []Field{{Name: "request", Value: request}}, sendErr),
+		v1.HookName_HOOK_NAME_ON_TRAFFIC_TO_SERVER)
+	if err != nil {
+		pr.Logger.Error().Err(err).Msg("Error running hook")
+		span.RecordError(err)
+		if errors.Is(err, gerr.ErrPluginFailedClosed) {
+			return err
+		}
+	}
+
+	metrics.ProxyPassThroughsToServer.Inc()
+
+	return sendErr
+}
+
+// runCopyHooks sends the summary of a COPY operation to the plugins. There is no
+// dedicated hook for COPY operations, so the summary is sent to the OnHook hooks
+// as the "onCopy" hook.
+func (pr *Proxy) runCopyHooks(conn *ConnWrapper, client *Client, summary *CopySummary) {
+	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "runCopyHooks")
+	defer span.End()
+
+	pr.Logger.Debug().Fields(
+		map[string]interface{}{
+			"direction": summary.Direction,
+			"rows":      summary.Rows,
+			"bytes":     summary.Bytes,
+			"messages":  summary.Messages,
+			"duration":  summary.Duration.String(),
+			"failed":    summary.Failed,
+		},
+	).Msg("COPY operation ended")
+
+	metrics.CopyOperations.WithLabelValues(summary.Direction).Inc()
+	metrics.BytesCopied.WithLabelValues(summary.Direction).Add(float64(summary.Bytes))
+
+	pluginTimeoutCtx, cancel := context.WithTimeout(context.Background(), pr.PluginTimeout)
+	defer cancel()
+
+	data := trafficData(conn.Conn(), client, nil, nil)
+	data["hook"] = OnCopyHook
+	data["direction"] = summary.Direction
+	data["rows"] = summary.Rows
+	data["bytes"] = summary.Bytes
+	data["messages"] = summary.Messages
+	data["duration"] = summary.Duration.Milliseconds()
+	data["failed"] = summary.Failed
+
+	_, err := pr.PluginRegistry.Run(
+		pluginTimeoutCtx,
+		data,
+		v1.HookName_HOOK_NAME_ON_HOOK)
+	if err != nil {
+		pr.Logger.Error().Err(err).Msg("Error running hook")
+		span.RecordError(err)
+	}
+	span.AddEvent("Ran the OnCopy hooks")
+}
+
 // IsHealthy checks if the pool is exhausted or the client is disconnected.
 func (pr *Proxy) IsHealthy(client *Client) (*Client, *gerr.GatewayDError) {
 	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "IsHealthy")
@@ -612,6 +976,85 @@ func (pr *Proxy) IsExhausted() bool {
 	return pr.AvailableConnections.Size() == 0 && pr.AvailableConnections.Cap() > 0
 }
 
+// PoolUsage returns the number of busy connections and the capacity of the pool.
+// The capacity is zero if the pool is unbounded.
+func (pr *Proxy) PoolUsage() (int, int) {
+	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "PoolUsage")
+	defer span.End()
+	return pr.busyConnections.Size(), pr.AvailableConnections.Cap()
+}
+
+// DialBackend opens a new connection to the database server and sends an SSLRequest to
+// check that the server still answers, without touching the connections in the pool.
+// Unlike a bare dial, this doesn't make the server log an incomplete startup packet.
+// The result is reused for backendCheckInterval, and the concurrent callers share it.
+// The dial timeout of the client is used if the timeout is zero.
+func (pr *Proxy) DialBackend(timeout time.Duration) *gerr.GatewayDError {
+	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "DialBackend")
+	defer span.End()
+
+	if pr.ClientConfig == nil {
+		return nil
+	}
+
+	pr.backendCheckMu.Lock()
+	defer pr.backendCheckMu.Unlock()
+
+	if time.Since(pr.backendCheckedAt) < backendCheckInterval {
+		return pr.backendCheckErr
+	}
+
+	if timeout <= 0 {
+		timeout = pr.ClientConfig.DialTimeout
+	}
+	if timeout <= 0 {
+		timeout = config.DefaultDialTimeout
+	}
+
+	pr.backendCheckErr = pr.checkBackend(timeout)
+	pr.backendCheckedAt = time.Now()
+	if pr.backendCheckErr != nil {
+		span.RecordError(pr.backendCheckErr)
+	}
+	return pr.backendCheckErr
+}
+
+// checkBackend sends an SSLRequest to the database server on a new connection and
+// waits for its answer, which is either 'S' or 'N'. The connection is closed before
+// the TLS handshake, which the server doesn't log.
+func (pr *Proxy) checkBackend(timeout time.Duration) *gerr.GatewayDError {
+	conn, err := net.DialTimeout(pr.ClientConfig.Network, pr.ClientConfig.Address, timeout)
+	if err != nil {
+		return gerr.ErrClientConnectionFailed.Wrap(err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			pr.Logger.Trace().Err(err).Msg("Failed to close the connection to the database server")
+		}
+	}()
+
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return gerr.ErrClientConnectionFailed.Wrap(err)
+	}
+
+	sslRequest := make([]byte, 8)                         //nolint:gomnd
+	binary.BigEndian.PutUint32(sslRequest[0:4], 8)        //nolint:gomnd
+	binary.BigEndian.PutUint32(sslRequest[4:8], 80877103) //nolint:gomnd
+	if _, err := conn.Write(sslRequest); err != nil {
+		return gerr.ErrClientConnectionFailed.Wrap(err)
+	}
+
+	answer := make([]byte, 1)
+	if _, err := io.ReadFull(conn, answer); err != nil {
+		return gerr.ErrClientConnectionFailed.Wrap(err)
+	}
+	if answer[0] != 'S' && answer[0] != 'N' {
+		return gerr.ErrClientConnectionFailed.Wrap(
+			fmt.Errorf("unexpected answer to the SSLRequest: %q", answer[0])) //nolint:goerr113
+	}
+	return nil
+}
+
 // Shutdown closes all connections and clears the connection pools.
 func (pr *Proxy) Shutdown() {
 	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "Shutdown")
@@ -675,25 +1118,352 @@ func (pr *Proxy) BusyConnectionsString() []string {
 
 	connections := make([]string, 0)
 	pr.busyConnections.ForEach(func(key, _ interface{}) bool {
-		if conn, ok := key.(net.Conn); ok {
-			connections = append(connections, RemoteAddr(conn))
+		if conn, ok := key.(*ConnWrapper); ok {
+			connections = append(connections, RemoteAddr(conn.Conn()))
 		}
 		return true
 	})
 	return connections
 }
 
+// Connections returns the information about the client connections and their server connections.
+func (pr *Proxy) Connections() []ConnectionInfo {
+	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "Connections")
+	defer span.End()
+
+	connections := make([]ConnectionInfo, 0)
+	pr.busyConnections.ForEach(func(key, value interface{}) bool {
+		conn, ok := key.(*ConnWrapper)
+		if !ok {
+			return true
+		}
+
+		user, database := conn.session.Startup()
+		info := ConnectionInfo{
+			ID:            conn.session.id,
+			ClientAddress: RemoteAddr(conn.Conn()),
+			TLS:           conn.IsTLSEnabled(),
+			User:          user,
+			Database:      database,
+			OpenedAt:      conn.session.openedAt,
+			BytesIn:       conn.session.bytesIn.Load(),
+			BytesOut:      conn.session.bytesOut.Load(),
+			State:         conn.session.State(),
+		}
+		if client, ok := value.(*Client); ok {
+			info.BackendAddress = client.RemoteAddr()
+			info.BackendLocalAddress = client.LocalAddr()
+		}
+		connections = append(connections, info)
+		return true
+	})
+
+	// The oldest connections come first.
+	slices.SortFunc(connections, func(a, b ConnectionInfo) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return connections
+}
+
+// KillConnection terminates the session with the given ID. The client receives
+// a FATAL error and the connection is closed, after which the server connection
+// is recycled, as if the client closed the connection.
+func (pr *Proxy) KillConnection(id uint64) *gerr.GatewayDError {
+	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "KillConnection")
+	defer span.End()
+
+	var target *ConnWrapper
+	pr.busyConnections.ForEach(func(key, _ interface{}) bool {
+		if conn, ok := key.(*ConnWrapper); ok && conn.session.id == id {
+			target = conn
+			return false
+		}
+		return true
+	})
+	if target == nil {
+		span.RecordError(gerr.ErrConnectionNotFound)
+		return gerr.ErrConnectionNotFound
+	}
+
+	pr.Logger.Info().Fields(
+		map[string]interface{}{
+			"id":     id,
+			"local":  LocalAddr(target.Conn()),
+			"remote": RemoteAddr(target.Conn()),
+		},
+	).Msg("Killing the connection")
+
+	// The error isn't interleaved with the response that is being sent to the client,
+	// but the traffic that is relayed might hold it for up to the relay check interval.
+	if err := target.writeFatal(&adminShutdown); err != nil {
+		pr.Logger.Debug().Err(err).Msg("Failed to send the error to the client")
+		span.RecordError(err)
+	}
+	// Closing the connection stops the pumps of the connection.
+	if err := target.Close(); err != nil {
+		pr.Logger.Debug().Err(err).Msg("Failed to close the connection")
+		span.RecordError(err)
+	}
+
+	return nil
+}
+
+// Pause holds the requests of the sessions between transactions until the proxy resumes,
+// so that the database can be restarted without the clients seeing errors. The sessions
+// in a transaction continue until the transaction ends. The sessions whose traffic is
+// relayed as is leave the relay once they are quiet, and they are held once the proxy
+// knows their transaction status again. UnheldConnections returns these sessions.
+func (pr *Proxy) Pause() {
+	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "Pause")
+	defer span.End()
+
+	pr.pauseMu.Lock()
+	defer pr.pauseMu.Unlock()
+
+	if pr.resumed == nil {
+		pr.resumed = make(chan struct{})
+		pr.Logger.Info().Msg("Paused the proxy")
+		pr.EventBus.Publish(events.ProxyPaused, "proxy", nil)
+	}
+}
+
+// Resume lets the held requests continue.
+func (pr *Proxy) Resume() {
+	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "Resume")
+	defer span.End()
+
+	pr.pauseMu.Lock()
+	defer pr.pauseMu.Unlock()
+
+	if pr.resumed != nil {
+		close(pr.resumed)
+		pr.resumed = nil
+		pr.Logger.Info().Msg("Resumed the proxy")
+		pr.EventBus.Publish(events.ProxyResumed, "proxy", nil)
+	}
+}
+
+// UnheldConnections returns the IDs of the client connections whose requests aren't
+// held while the proxy is paused, because the proxy doesn't know their transaction status.
+func (pr *Proxy) UnheldConnections() []uint64 {
+	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "UnheldConnections")
+	defer span.End()
+
+	ids := make([]uint64, 0)
+	pr.busyConnections.ForEach(func(key, _ interface{}) bool {
+		if conn, ok := key.(*ConnWrapper); ok && !conn.session.Holdable() {
+			ids = append(ids, conn.session.id)
+		}
+		return true
+	})
+	slices.Sort(ids)
+
+	return ids
+}
+
+// IsPaused returns true if the proxy is paused.
+func (pr *Proxy) IsPaused() bool {
+	pr.pauseMu.Lock()
+	defer pr.pauseMu.Unlock()
+
+	return pr.resumed != nil
+}
+
+// waitIfPaused waits until the proxy resumes or the connection is closed.
+func (pr *Proxy) waitIfPaused(conn *ConnWrapper) *gerr.GatewayDError {
+	pr.pauseMu.Lock()
+	resumed := pr.resumed
+	pr.pauseMu.Unlock()
+
+	if resumed == nil {
+		return nil
+	}
+
+	select {
+	case <-resumed:
+		return nil
+	case <-conn.session.closed:
+		return gerr.ErrClientNotConnected
+	}
+}
+
+// Reconnect recycles the server connections gracefully. The available connections are
+// reconnected immediately and the busy ones are reconnected when their sessions end.
+func (pr *Proxy) Reconnect() *gerr.GatewayDError {
+	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "Reconnect")
+	defer span.End()
+
+	var ids []string
+	pr.AvailableConnections.ForEach(func(key, _ interface{}) bool {
+		if id, ok := key.(string); ok {
+			ids = append(ids, id)
+		}
+		return true
+	})
+
+	var reconnectErr *gerr.GatewayDError
+	for _, id := range ids {
+		// The client is taken out of the pool, so that no session gets it meanwhile.
+		client, ok := pr.AvailableConnections.Pop(id).(*Client)
+		if !ok {
+			continue
+		}
+
+		if err := client.Reconnect(); err != nil {
+			pr.Logger.Error().Err(err).Msg("Failed to reconnect to the server")
+			span.RecordError(err)
+			pr.publishReconnectFailed(client, err)
+			reconnectErr = gerr.ErrClientConnectionFailed.Wrap(err)
+		}
+
+		if err := pr.AvailableConnections.Put(client.ID, client); err != nil {
+			pr.Logger.Error().Err(err).Msg("Failed to put the client back in the pool")
+			span.RecordError(err)
+			client.Close()
+		}
+	}
+
+	pr.Logger.Info().Fields(
+		map[string]interface{}{
+			"available": len(ids),
+			"busy":      pr.busyConnections.Size(),
+		},
+	).Msg("Reconnected the server connections")
+
+	return reconnectErr
+}
+
+// Resize changes the number of server connections. The new connections are created
+// immediately. If the pool shrinks, the available connections are closed immediately
+// and the busy ones are closed when their sessions end.
+func (pr *Proxy) Resize(size int) *gerr.GatewayDError {
+	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "Resize")
+	defer span.End()
+
+	if size < 1 {
+		err := gerr.ErrValidationFailed.Wrap(
+			fmt.Errorf("pool size must be positive, got %d", size)) //nolint:goerr113
+		span.RecordError(err)
+		return err
+	}
+
+	pr.AvailableConnections.Resize(size)
+	busy := pr.busyConnections.Size()
+
+	// Close the available connections that exceed the new size.
+	var ids []string
+	pr.AvailableConnections.ForEach(func(key, _ interface{}) bool {
+		if id, ok := key.(string); ok {
+			ids = append(ids, id)
+		}
+		return true
+	})
+	for _, id := range ids {
+		if pr.AvailableConnections.Size()+busy <= size {
+			break
+		}
+		if client, ok := pr.AvailableConnections.Pop(id).(*Client); ok {
+			client.Close()
+		}
+	}
+
+	// Create the connections that are missing.
+	for pr.AvailableConnections.Size()+busy < size {
+		client, err := pr.newClient()
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+		if err := pr.AvailableConnections.Put(client.ID, client); err != nil {
+			client.Close()
+			span.RecordError(err)
+			return err
+		}
+	}
+
+	pr.Logger.Info().Fields(
+		map[string]interface{}{
+			"size":      size,
+			"available": pr.AvailableConnections.Size(),
+			"busy":      busy,
+		},
+	).Msg("Resized the pool")
+
+	return nil
+}
+
+// newClient creates a new server connection and runs the OnNewClient hooks.
+func (pr *Proxy) newClient() (*Client, *gerr.GatewayDError) {
+	client := NewClient(
+		pr.ctx, pr.ClientConfig, pr.Logger,
+		NewRetry(
+			Retry{
+				Retries: pr.ClientConfig.Retries,
+				Backoff: config.If(
+					pr.ClientConfig.Backoff > 0,
+					pr.ClientConfig.Backoff,
+					config.DefaultBackoff,
+				),
+				BackoffMultiplier:  pr.ClientConfig.BackoffMultiplier,
+				DisableBackoffCaps: pr.ClientConfig.DisableBackoffCaps,
+				Logger:             pr.Logger,
+			},
+		),
+	)
+	if client == nil {
+		return nil, gerr.ErrClientConnectionFailed
+	}
+
+	pluginTimeoutCtx, cancel := context.WithTimeout(context.Background(), pr.PluginTimeout)
+	defer cancel()
+
+	clientCfg := map[string]interface{}{
+		"id":                 client.ID,
+		"network":            client.Network,
+		"address":            client.Address,
+		"receiveChunkSize":   client.ReceiveChunkSize,
+		"receiveDeadline":    client.ReceiveDeadline.String(),
+		"receiveTimeout":     client.ReceiveTimeout.String(),
+		"sendDeadline":       client.SendDeadline.String(),
+		"dialTimeout":        client.DialTimeout.String(),
+		"tcpKeepAlive":       client.TCPKeepAlive,
+		"tcpKeepAlivePeriod": client.TCPKeepAlivePeriod.String(),
+		"localAddress":       client.LocalAddr(),
+		"remoteAddress":      client.RemoteAddr(),
+		"retries":            pr.ClientConfig.Retries,
+		"backoff":            client.Retry().Backoff.String(),
+		"backoffMultiplier":  pr.ClientConfig.BackoffMultiplier,
+		"disableBackoffCaps": pr.ClientConfig.DisableBackoffCaps,
+	}
+	if _, err := pr.PluginRegistry.Run(
+		pluginTimeoutCtx, clientCfg, v1.HookName_HOOK_NAME_ON_NEW_CLIENT); err != nil {
+		pr.Logger.Error().Err(err).Msg("Failed to run OnNewClient hooks")
+		if errors.Is(err, gerr.ErrPluginFailedClosed) {
+			// The connection isn't added to the pool.
+			client.Close()
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
 // receiveTrafficFromClient is a function that waits to receive data from the client.
-func (pr *Proxy) receiveTrafficFromClient(conn net.Conn) ([]byte, *gerr.GatewayDError) {
+// If streaming is true, it returns after receiving a single chunk.
+func (pr *Proxy) receiveTrafficFromClient(
+	conn net.Conn, streaming bool,
+) ([]byte, *gerr.GatewayDError) {
 	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "receiveTrafficFromClient")
 	defer span.End()
 
 	// request contains the data from the client.
 	received := 0
 	buffer := bytes.NewBuffer(nil)
+	chunk := getBuffer(pr.ClientConfig.ReceiveChunkSize)
+	defer putBuffer(chunk)
 	for {
-		chunk := make([]byte, pr.ClientConfig.ReceiveChunkSize)
-		read, err := conn.Read(chunk)
+		read, err := conn.Read(*chunk)
 		if read == 0 || err != nil {
 			pr.Logger.Debug().Err(err).Msg("Error reading from client")
 			span.RecordError(err)
@@ -701,13 +1471,14 @@ func (pr *Proxy) receiveTrafficFromClient(conn net.Conn) ([]byte, *gerr.GatewayD
 			metrics.BytesReceivedFromClient.Observe(float64(read))
 			metrics.TotalTrafficBytes.Observe(float64(read))
 
-			return chunk[:read], gerr.ErrReadFailed.Wrap(err)
+			// The chunk is returned to the pool, so return a copy of it.
+			return bytes.Clone((*chunk)[:read]), gerr.ErrReadFailed.Wrap(err)
 		}
 
 		received += read
-		buffer.Write(chunk[:read])
+		buffer.Write((*chunk)[:read])
 
-		if received == 0 || received < pr.ClientConfig.ReceiveChunkSize {
+		if streaming || received == 0 || received < pr.ClientConfig.ReceiveChunkSize {
 			break
 		}
 
@@ -767,12 +1538,19 @@ func (pr *Proxy) sendTrafficToServer(client *Client, request []byte) (int, *gerr
 }
 
 // receiveTrafficFromServer is a function that receives data from the server.
-func (pr *Proxy) receiveTrafficFromServer(client *Client) (int, []byte, *gerr.GatewayDError) {
+// If streaming is true, it returns after receiving a single chunk.
+func (pr *Proxy) receiveTrafficFromServer(
+	client *Client, streaming bool,
+) (int, []byte, *gerr.GatewayDError) {
 	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "receiveTrafficFromServer")
 	defer span.End()
 
 	// Receive the response from the server.
-	received, response, err := client.Receive()
+	receive := client.Receive
+	if streaming {
+		receive = client.ReceiveChunk
+	}
+	received, response, err := receive()
 
 	fields := map[string]interface{}{
 		"function": "proxy.passthrough",
@@ -797,7 +1575,7 @@ func (pr *Proxy) receiveTrafficFromServer(client *Client) (int, []byte, *gerr.Ga
 
 // sendTrafficToClient is a function that sends data to the client.
 func (pr *Proxy) sendTrafficToClient(
-	conn net.Conn, response []byte, received int,
+	conn *ConnWrapper, response []byte, received int,
 ) *gerr.GatewayDError {
 	_, span := otel.Tracer(config.TracerName).Start(pr.ctx, "sendTrafficToClient")
 	defer span.End()
@@ -823,8 +1601,8 @@ func (pr *Proxy) sendTrafficToClient(
 		map[string]interface{}{
 			"function": "proxy.passthrough",
 			"length":   sent,
-			"local":    LocalAddr(conn),
-			"remote":   RemoteAddr(conn),
+			"local":    LocalAddr(conn.Conn()),
+			"remote":   RemoteAddr(conn.Conn()),
 		},
 	).Msg("Sent data to client")
 
@@ -928,3 +1706,11 @@ func (pr *Proxy) isConnectionHealthy(conn net.Conn) bool {
 
 	return true
 }
+
+// publishReconnectFailed publishes the event of a server connection that failed to reconnect.
+func (pr *Proxy) publishReconnectFailed(client *Client, err error) {
+	pr.EventBus.Publish(events.BackendReconnectFailed, "proxy", map[string]interface{}{
+		"backend": client.Address,
+		"error":   err.Error(),
+	})
+}
@@ -1,17 +1,26 @@
 package network
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"net"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	v1 "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin/v1"
 	"github.com/gatewayd-io/gatewayd/act"
 	"github.com/gatewayd-io/gatewayd/config"
+	gerr "github.com/gatewayd-io/gatewayd/errors"
 	"github.com/gatewayd-io/gatewayd/logging"
 	"github.com/gatewayd-io/gatewayd/plugin"
 	"github.com/gatewayd-io/gatewayd/pool"
+	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 )
 
 // TestNewProxy tests the creation of a new proxy with a fixed connection pool.
@@ -408,3 +417,532 @@ func BenchmarkProxyAvailableAndBusyConnectionsString(b *testing.B) {
 		proxy.BusyConnectionsString()
 	}
 }
+
+// echoBackend sends back everything it receives.
+func echoBackend(conn net.Conn) {
+	_, _ = io.Copy(conn, conn)
+}
+
+// receiveAll receives from the client until the given number of bytes is received.
+func receiveAll(t testing.TB, client *Client, size int) []byte {
+	t.Helper()
+
+	received := make([]byte, 0, size)
+	for len(received) < size {
+		_, data, err := client.Receive()
+		require.Nil(t, err)
+		received = append(received, data...)
+	}
+
+	return received
+}
+
+// TestProxyRelay tests that the traffic is relayed as is after the startup message
+// when no plugin receives the traffic.
+func TestProxyRelay(t *testing.T) {
+	backend := StartFakeBackend(t, echoBackend)
+	server, proxy, pluginRegistry := NewTestServer(t, "127.0.0.1:15438", backend, 1)
+	RunTestServer(t, server, pluginRegistry)
+	client := NewTestClient(t, "127.0.0.1:15438")
+
+	// The startup message is passed through in chunks.
+	startup := CreatePgStartupPacket()
+	_, err := client.Send(startup)
+	require.Nil(t, err)
+	assert.Equal(t, startup, receiveAll(t, client, len(startup)))
+	assert.True(t, proxy.canRelay(conn(t, proxy)))
+
+	// The rest of the traffic is relayed, regardless of the chunk size.
+	request := bytes.Repeat([]byte("gatewayd"), config.DefaultChunkSize)
+	_, err = client.Send(request)
+	require.Nil(t, err)
+	assert.Equal(t, request, receiveAll(t, client, len(request)))
+
+	// The relayed traffic is counted while the connection is open.
+	require.Eventually(t, func() bool {
+		connections := proxy.Connections()
+		return len(connections) == 1 &&
+			connections[0].BytesIn == int64(len(startup)+len(request)) &&
+			connections[0].BytesOut == int64(len(startup)+len(request))
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, SessionRelayed, proxy.Connections()[0].State)
+
+	// Any traffic hook disables relaying, including for the connections that are
+	// already relayed, once they are quiet.
+	var hooked atomic.Int32
+	pluginRegistry.AddHook(v1.HookName_HOOK_NAME_ON_TRAFFIC_TO_CLIENT, 1,
+		func(ctx context.Context, params *v1.Struct, opts ...grpc.CallOption) (*v1.Struct, error) {
+			hooked.Add(1)
+			return onOutgoingTraffic(ctx, params, opts...)
+		})
+	assert.False(t, proxy.canRelay(conn(t, proxy)))
+	require.Eventually(t, func() bool {
+		return proxy.Connections()[0].State != SessionRelayed
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The hook sees the traffic of the connection from now on.
+	_, err = client.Send(startup)
+	require.Nil(t, err)
+	assert.Equal(t, startup, receiveAll(t, client, len(startup)))
+	assert.Positive(t, hooked.Load())
+}
+
+// BenchmarkProxyRelay compares the throughput of relaying the traffic
+// with passing it through the traffic hooks.
+func BenchmarkProxyRelay(b *testing.B) {
+	for i, hooks := range []bool{false, true} {
+		name := "relay"
+		if hooks {
+			name = "hooks"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			backend := StartFakeBackend(b, echoBackend)
+			address := "127.0.0.1:" + []string{"15439", "15440"}[i]
+			server, _, pluginRegistry := NewTestServer(b, address, backend, 1)
+			if hooks {
+				pluginRegistry.AddHook(v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT, 1, onIncomingTraffic)
+				pluginRegistry.AddHook(v1.HookName_HOOK_NAME_ON_TRAFFIC_TO_SERVER, 1, onIncomingTraffic)
+				pluginRegistry.AddHook(v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_SERVER, 1, onOutgoingTraffic)
+				pluginRegistry.AddHook(v1.HookName_HOOK_NAME_ON_TRAFFIC_TO_CLIENT, 1, onOutgoingTraffic)
+			}
+			RunTestServer(b, server, pluginRegistry)
+			client := NewTestClient(b, address)
+			require.NoError(b, client.conn.SetReadDeadline(time.Time{}))
+
+			startup := CreatePgStartupPacket()
+			_, err := client.Send(startup)
+			require.Nil(b, err)
+			receiveAll(b, client, len(startup))
+
+			// The request fits in a chunk, so that the server's response is
+			// received in one go when it is passed through the hooks.
+			request := bytes.Repeat([]byte("gatewayd"), config.DefaultChunkSize/16)
+			b.SetBytes(int64(len(request)))
+			b.ResetTimer()
+			for range b.N {
+				if _, err := client.Send(request); err != nil {
+					b.Fatal(err)
+				}
+				receiveAll(b, client, len(request))
+			}
+		})
+	}
+}
+
+// TestProxyConnections tests listing the client connections and killing them.
+func TestProxyConnections(t *testing.T) {
+	ready := EncodePgMessages(t,
+		&pgproto3.AuthenticationOk{},
+		&pgproto3.ReadyForQuery{TxStatus: 'T'},
+	)
+	backend := StartFakeBackend(t, func(conn net.Conn) {
+		buffer := make([]byte, 1024)
+		// Wait for the startup message.
+		if _, err := conn.Read(buffer); err != nil {
+			return
+		}
+		_, _ = conn.Write(ready)
+	})
+	server, proxy, pluginRegistry := NewTestServer(t, "127.0.0.1:15444", backend, 1)
+	// The traffic hook makes the proxy follow the protocol.
+	pluginRegistry.AddHook(v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT, 1, onIncomingTraffic)
+	RunTestServer(t, server, pluginRegistry)
+	assert.Empty(t, proxy.Connections())
+
+	client := NewTestClient(t, "127.0.0.1:15444")
+	startup := EncodePgMessages(t, &pgproto3.StartupMessage{
+		ProtocolVersion: protocolVersion3,
+		Parameters:      map[string]string{"user": "alice", "database": "sales"},
+	})
+	_, err := client.Send(startup)
+	require.Nil(t, err)
+	assert.Equal(t, ready, receiveAll(t, client, len(ready)))
+
+	connections := proxy.Connections()
+	require.Len(t, connections, 1)
+	connection := connections[0]
+	assert.Equal(t, client.LocalAddr(), connection.ClientAddress)
+	assert.Equal(t, backend, connection.BackendAddress)
+	assert.NotEmpty(t, connection.BackendLocalAddress)
+	assert.False(t, connection.TLS)
+	assert.Equal(t, "alice", connection.User)
+	assert.Equal(t, "sales", connection.Database)
+	assert.WithinDuration(t, time.Now(), connection.OpenedAt, 5*time.Second)
+	assert.Equal(t, int64(len(startup)), connection.BytesIn)
+	assert.Equal(t, int64(len(ready)), connection.BytesOut)
+	assert.Equal(t, SessionIdleInTransaction, connection.State)
+
+	// Unknown connections can't be killed.
+	assert.ErrorIs(t, proxy.KillConnection(connection.ID+1), gerr.ErrConnectionNotFound)
+
+	// The client receives a FATAL error before the connection is closed.
+	require.Nil(t, proxy.KillConnection(connection.ID))
+	size, data, err := client.Receive()
+	require.Nil(t, err)
+	assert.Equal(t, errorResponse, data[0])
+	assert.Contains(t, string(data[:size]), "FATAL")
+	assert.Contains(t, string(data[:size]), adminShutdown.Code)
+
+	require.Eventually(t, func() bool {
+		return server.CountConnections() == 0 && proxy.AvailableConnections.Size() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Empty(t, proxy.Connections())
+}
+
+// queryBackend answers the startup message and every query with a ReadyForQuery
+// message, whose transaction status depends on the query.
+func queryBackend(t *testing.T) func(conn net.Conn) {
+	t.Helper()
+
+	return func(conn net.Conn) {
+		buffer := make([]byte, 1024)
+		txStatus := byte('I')
+		for {
+			read, err := conn.Read(buffer)
+			if err != nil {
+				return
+			}
+
+			switch {
+			case bytes.Contains(buffer[:read], []byte("BEGIN")):
+				txStatus = 'T'
+			case bytes.Contains(buffer[:read], []byte("COMMIT")):
+				txStatus = 'I'
+			}
+			_, _ = conn.Write(EncodePgMessages(t, &pgproto3.ReadyForQuery{TxStatus: txStatus}))
+		}
+	}
+}
+
+// TestProxyPause tests that the requests are held at transaction boundaries while
+// the proxy is paused.
+func TestProxyPause(t *testing.T) {
+	server, proxy, pluginRegistry := NewTestServer(
+		t, "127.0.0.1:15445", StartFakeBackend(t, queryBackend(t)), 1)
+	// The traffic hook makes the proxy follow the protocol.
+	pluginRegistry.AddHook(v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT, 1, onIncomingTraffic)
+	RunTestServer(t, server, pluginRegistry)
+	client := NewTestClient(t, "127.0.0.1:15445")
+
+	query := func(query string) <-chan byte {
+		_, err := client.Send(EncodePgMessages(t, &pgproto3.Query{String: query}))
+		require.Nil(t, err)
+
+		txStatus := make(chan byte, 1)
+		go func() {
+			size, data, err := client.Receive()
+			if err == nil && size > 0 {
+				txStatus <- data[size-1]
+			}
+		}()
+		return txStatus
+	}
+
+	startup := CreatePgStartupPacket()
+	_, err := client.Send(startup)
+	require.Nil(t, err)
+	receiveAll(t, client, 6)
+
+	assert.Equal(t, byte('T'), <-query("BEGIN"))
+	proxy.Pause()
+	assert.True(t, proxy.IsPaused())
+
+	// The transaction in progress continues.
+	assert.Equal(t, byte('T'), <-query("SELECT 1"))
+	assert.Equal(t, byte('I'), <-query("COMMIT"))
+
+	// The next transaction waits until the proxy resumes.
+	held := query("SELECT 2")
+	select {
+	case <-held:
+		t.Fatal("The query was not held")
+	case <-time.After(200 * time.Millisecond):
+	}
+	assert.Equal(t, SessionActive, proxy.Connections()[0].State)
+
+	proxy.Resume()
+	assert.False(t, proxy.IsPaused())
+	select {
+	case txStatus := <-held:
+		assert.Equal(t, byte('I'), txStatus)
+	case <-time.After(5 * time.Second):
+		t.Fatal("The query was not resumed")
+	}
+}
+
+// TestProxyPauseRelayed tests that the sessions whose traffic is relayed are held once
+// the proxy knows their transaction status, and that they are reported until then.
+func TestProxyPauseRelayed(t *testing.T) {
+	server, proxy, pluginRegistry := NewTestServer(
+		t, "127.0.0.1:15448", StartFakeBackend(t, queryBackend(t)), 1)
+	RunTestServer(t, server, pluginRegistry)
+	client := NewTestClient(t, "127.0.0.1:15448")
+
+	query := func(query string) <-chan byte {
+		_, err := client.Send(EncodePgMessages(t, &pgproto3.Query{String: query}))
+		require.Nil(t, err)
+
+		txStatus := make(chan byte, 1)
+		go func() {
+			size, data, err := client.Receive()
+			if err == nil && size > 0 {
+				txStatus <- data[size-1]
+			}
+		}()
+		return txStatus
+	}
+
+	startup := CreatePgStartupPacket()
+	_, err := client.Send(startup)
+	require.Nil(t, err)
+	receiveAll(t, client, 6)
+
+	// The traffic is relayed, so the session can't be held.
+	assert.Equal(t, byte('I'), <-query("SELECT 1"))
+	require.Eventually(t, func() bool {
+		return proxy.Connections()[0].State == SessionRelayed
+	}, 5*time.Second, 10*time.Millisecond)
+	id := proxy.Connections()[0].ID
+	assert.Equal(t, []uint64{id}, proxy.UnheldConnections())
+
+	// The quiet session leaves the relay once the proxy is paused, but its transaction
+	// status is unknown, so the next query still passes through.
+	proxy.Pause()
+	require.Eventually(t, func() bool {
+		return proxy.Connections()[0].State != SessionRelayed
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []uint64{id}, proxy.UnheldConnections())
+	select {
+	case txStatus := <-query("SELECT 2"):
+		assert.Equal(t, byte('I'), txStatus)
+	case <-time.After(5 * time.Second):
+		t.Fatal("The query was held")
+	}
+	assert.Empty(t, proxy.UnheldConnections())
+
+	// The next transaction waits until the proxy resumes.
+	held := query("SELECT 3")
+	select {
+	case <-held:
+		t.Fatal("The query was not held")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	proxy.Resume()
+	select {
+	case txStatus := <-held:
+		assert.Equal(t, byte('I'), txStatus)
+	case <-time.After(5 * time.Second):
+		t.Fatal("The query was not resumed")
+	}
+}
+
+// TestProxyResize tests resizing and reconnecting the server connections.
+func TestProxyResize(t *testing.T) {
+	server, proxy, pluginRegistry := NewTestServer(
+		t, "127.0.0.1:15446", StartFakeBackend(t, queryBackend(t)), 2)
+	RunTestServer(t, server, pluginRegistry)
+
+	// Reconnecting recycles the available connections.
+	before := proxy.AvailableConnectionsString()
+	require.Nil(t, proxy.Reconnect())
+	after := proxy.AvailableConnectionsString()
+	assert.Len(t, after, 2)
+	assert.NotContains(t, after, before[0])
+	assert.NotContains(t, after, before[1])
+
+	require.Nil(t, proxy.Resize(4))
+	assert.Equal(t, 4, proxy.AvailableConnections.Cap())
+	assert.Equal(t, 4, proxy.AvailableConnections.Size())
+
+	assert.ErrorIs(t, proxy.Resize(0), gerr.ErrValidationFailed)
+
+	// The busy connection is kept until the client disconnects.
+	client := NewTestClient(t, "127.0.0.1:15446")
+	require.Eventually(t, func() bool {
+		return proxy.busyConnections.Size() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Nil(t, proxy.Resize(1))
+	assert.Equal(t, 0, proxy.AvailableConnections.Size())
+	assert.Equal(t, 1, proxy.busyConnections.Size())
+
+	client.Close()
+	require.Eventually(t, func() bool {
+		return proxy.AvailableConnections.Size() == 1 && proxy.busyConnections.Size() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// TestProxyDialBackend tests that the database server must answer the SSLRequest,
+// and that the result of the check is reused for backendCheckInterval.
+func TestProxyDialBackend(t *testing.T) {
+	backend, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer backend.Close()
+
+	var dials atomic.Int32
+	var answer atomic.Int32
+	answer.Store('N')
+	go func() {
+		for {
+			conn, err := backend.Accept()
+			if err != nil {
+				return
+			}
+			dials.Add(1)
+			request := make([]byte, 8)
+			if _, err := io.ReadFull(conn, request); err == nil && IsPostgresSSLRequest(request) {
+				_, _ = conn.Write([]byte{byte(answer.Load())})
+			}
+			conn.Close()
+		}
+	}()
+
+	proxy := NewProxy(
+		context.Background(),
+		Proxy{
+			AvailableConnections: pool.NewPool(context.Background(), config.EmptyPoolCapacity),
+			Logger:               logging.NewLogger(context.Background(), logging.LoggerConfig{NoColor: true}),
+			HealthCheckPeriod:    config.DefaultHealthCheckPeriod,
+			ClientConfig:         &config.Client{Network: "tcp", Address: backend.Addr().String()},
+		},
+	)
+	defer proxy.Shutdown()
+
+	require.Nil(t, proxy.DialBackend(time.Second))
+	require.Nil(t, proxy.DialBackend(time.Second))
+	assert.Equal(t, int32(1), dials.Load())
+
+	// The server doesn't answer like a database server.
+	answer.Store('X')
+	proxy.backendCheckedAt = time.Time{}
+	assert.ErrorIs(t, proxy.DialBackend(time.Second), gerr.ErrClientConnectionFailed)
+	assert.Equal(t, int32(2), dials.Load())
+}
+
+// fakeAdminConsole accepts the password "secret", answers "SHOW POOLS" and "PAUSE",
+// and fails the other commands.
+type fakeAdminConsole struct{}
+
+func (fakeAdminConsole) Authenticate(_ AdminClient, password string) error {
+	if password != "secret" {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (fakeAdminConsole) Run(_ context.Context, _ AdminClient, command string) (*AdminResult, error) {
+	switch command {
+	case "SHOW POOLS":
+		return &AdminResult{
+			Columns: []string{"name", "size"},
+			Rows:    [][]string{{"default", "1"}},
+			Tag:     "SHOW",
+		}, nil
+	case "PAUSE":
+		return &AdminResult{Tag: "PAUSE"}, nil
+	}
+	return nil, io.ErrUnexpectedEOF
+}
+
+// TestProxyAdminConsole tests that the clients of the admin database are answered
+// locally, without using the server connection.
+func TestProxyAdminConsole(t *testing.T) {
+	var used atomic.Bool
+	server, proxy, pluginRegistry := NewTestServer(
+		t, "127.0.0.1:15447", StartFakeBackend(t, func(conn net.Conn) {
+			if read, _ := conn.Read(make([]byte, 1024)); read > 0 {
+				used.Store(true)
+			}
+		}), 1)
+	server.AdminDatabase = "gatewayd"
+	server.AdminConsole = fakeAdminConsole{}
+	RunTestServer(t, server, pluginRegistry)
+
+	var frontend *pgproto3.Frontend
+	receive := func(expected pgproto3.BackendMessage) pgproto3.BackendMessage {
+		t.Helper()
+		message, err := frontend.Receive()
+		require.NoError(t, err)
+		require.IsType(t, expected, message)
+		return message
+	}
+	login := func(password string) {
+		t.Helper()
+		conn, err := net.Dial("tcp", "127.0.0.1:15447")
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		frontend = pgproto3.NewFrontend(conn, conn)
+
+		frontend.Send(&pgproto3.StartupMessage{
+			ProtocolVersion: protocolVersion3,
+			Parameters:      map[string]string{"user": "postgres", "database": "gatewayd"},
+		})
+		require.NoError(t, frontend.Flush())
+		receive(&pgproto3.AuthenticationCleartextPassword{})
+		frontend.Send(&pgproto3.PasswordMessage{Password: password})
+		require.NoError(t, frontend.Flush())
+	}
+
+	// The clients with a wrong password receive a FATAL error.
+	login("wrong")
+	failed, ok := receive(&pgproto3.ErrorResponse{}).(*pgproto3.ErrorResponse)
+	require.True(t, ok)
+	assert.Equal(t, "FATAL", failed.Severity)
+	assert.Equal(t, "28P01", failed.Code)
+	require.Eventually(t, func() bool {
+		return proxy.AvailableConnections.Size() == 1 && len(proxy.Connections()) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	login("secret")
+	receive(&pgproto3.AuthenticationOk{})
+	for {
+		message, err := frontend.Receive()
+		require.NoError(t, err)
+		if _, ok := message.(*pgproto3.ReadyForQuery); ok {
+			break
+		}
+		require.IsType(t, &pgproto3.ParameterStatus{}, message)
+	}
+	require.Eventually(t, func() bool {
+		connections := proxy.Connections()
+		return len(connections) == 1 && connections[0].State == SessionAdmin
+	}, 5*time.Second, 10*time.Millisecond)
+
+	frontend.Send(&pgproto3.Query{String: "SHOW POOLS; PAUSE;"})
+	require.NoError(t, frontend.Flush())
+	description, ok := receive(&pgproto3.RowDescription{}).(*pgproto3.RowDescription)
+	require.True(t, ok)
+	require.Len(t, description.Fields, 2)
+	assert.Equal(t, "name", string(description.Fields[0].Name))
+	row, ok := receive(&pgproto3.DataRow{}).(*pgproto3.DataRow)
+	require.True(t, ok)
+	assert.Equal(t, [][]byte{[]byte("default"), []byte("1")}, row.Values)
+	complete, ok := receive(&pgproto3.CommandComplete{}).(*pgproto3.CommandComplete)
+	require.True(t, ok)
+	assert.Equal(t, "SHOW", string(complete.CommandTag))
+	complete, ok = receive(&pgproto3.CommandComplete{}).(*pgproto3.CommandComplete)
+	require.True(t, ok)
+	assert.Equal(t, "PAUSE", string(complete.CommandTag))
+	receive(&pgproto3.ReadyForQuery{})
+
+	// The failed commands return an error, after which the session continues.
+	frontend.Send(&pgproto3.Query{String: "DROP TABLE users"})
+	require.NoError(t, frontend.Flush())
+	failed, ok = receive(&pgproto3.ErrorResponse{}).(*pgproto3.ErrorResponse)
+	require.True(t, ok)
+	assert.Equal(t, "ERROR", failed.Severity)
+	receive(&pgproto3.ReadyForQuery{})
+
+	frontend.Send(&pgproto3.Query{String: " ; "})
+	require.NoError(t, frontend.Flush())
+	receive(&pgproto3.EmptyQueryResponse{})
+	receive(&pgproto3.ReadyForQuery{})
+
+	frontend.Send(&pgproto3.Terminate{})
+	require.NoError(t, frontend.Flush())
+	require.Eventually(t, func() bool {
+		return proxy.AvailableConnections.Size() == 1 && len(proxy.Connections()) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.False(t, used.Load(), "The server connection was used")
+}
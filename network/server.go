@@ -15,11 +15,13 @@ import (
 	v1 "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin/v1"
 	"github.com/gatewayd-io/gatewayd/config"
 	gerr "github.com/gatewayd-io/gatewayd/errors"
+	"github.com/gatewayd-io/gatewayd/events"
 	"github.com/gatewayd-io/gatewayd/metrics"
 	"github.com/gatewayd-io/gatewayd/plugin"
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Option struct {
@@ -38,7 +40,7 @@ type IServer interface {
 	OnBoot() Action
 	OnOpen(conn *ConnWrapper) ([]byte, Action)
 	OnClose(conn *ConnWrapper, err error) Action
-	OnTraffic(conn *ConnWrapper, stopConnection chan struct{}) Action
+	OnTraffic(conn *ConnWrapper) Action
 	OnShutdown()
 	OnTick() (time.Duration, Action)
 	Run() *gerr.GatewayDError
@@ -67,6 +69,19 @@ type Server struct {
 	KeyFile          string
 	HandshakeTimeout time.Duration
 
+	// Session timeouts, which are disabled if zero.
+	IdleTimeout              time.Duration
+	IdleInTransactionTimeout time.Duration
+	MaxSessionDuration       time.Duration
+
+	// The clients that connect to the admin database use the admin console,
+	// which is disabled if either of them is not set.
+	AdminDatabase string
+	AdminConsole  IAdminConsole
+
+	// EventBus receives the connection opened and closed events.
+	EventBus *events.Bus
+
 	listener    net.Listener
 	host        string
 	port        int
@@ -103,6 +118,7 @@ func (s *Server) OnBoot() Action {
 	s.mu.Lock()
 	s.Status = config.Running
 	s.mu.Unlock()
+	s.EventBus.Publish(events.ServerStarted, "server", map[string]interface{}{"server": s.Address})
 
 	// Run the OnBooted hooks.
 	pluginTimeoutCtx, cancel = context.WithTimeout(context.Background(), s.PluginTimeout)
@@ -146,6 +162,9 @@ func (s *Server) OnOpen(conn *ConnWrapper) ([]byte, Action) {
 	if err != nil {
 		s.Logger.Error().Err(err).Msg("Failed to run OnOpening hook")
 		span.RecordError(err)
+		if errors.Is(err, gerr.ErrPluginFailedClosed) {
+			return nil, Close
+		}
 	}
 	span.AddEvent("Ran the OnOpening hooks")
 
@@ -180,11 +199,22 @@ func (s *Server) OnOpen(conn *ConnWrapper) ([]byte, Action) {
 	if err != nil {
 		s.Logger.Error().Err(err).Msg("Failed to run OnOpened hook")
 		span.RecordError(err)
+		if errors.Is(err, gerr.ErrPluginFailedClosed) {
+			// The server connection is recycled by OnClose.
+			return nil, Close
+		}
 	}
 	span.AddEvent("Ran the OnOpened hooks")
 
 	metrics.ClientConnections.Inc()
 
+	s.EventBus.Publish(events.ConnectionOpened, "server", map[string]interface{}{
+		"id":     conn.session.id,
+		"server": s.Address,
+		"client": RemoteAddr(conn.Conn()),
+		"tls":    conn.IsTLSEnabled(),
+	})
+
 	return nil, None
 }
 
@@ -269,12 +299,30 @@ func (s *Server) OnClose(conn *ConnWrapper, err error) Action {
 
 	metrics.ClientConnections.Dec()
 
+	user, database := conn.session.Startup()
+	s.EventBus.Publish(events.ConnectionClosed, "server", map[string]interface{}{
+		"id":       conn.session.id,
+		"server":   s.Address,
+		"client":   RemoteAddr(conn.Conn()),
+		"user":     user,
+		"database": database,
+		"duration": time.Since(conn.session.openedAt).String(),
+		"bytesIn":  conn.session.bytesIn.Load(),
+		"bytesOut": conn.session.bytesOut.Load(),
+		"error":    data["error"],
+	})
+
 	return Close
 }
 
 // OnTraffic is called when data is received from the client. It calls the OnTraffic hooks.
-// It then passes the traffic to the proxied connection.
-func (s *Server) OnTraffic(conn *ConnWrapper, stopConnection chan struct{}) Action {
+// It then starts two independent pumps, one passing the traffic from the client to the
+// server and the other from the server to the client. Each pump runs its own hooks and
+// only reads the next message after the previous one is written, so a slow reader on
+// one side doesn't hold back the other direction. Both pumps share the lifecycle of the
+// connection: the connection is closed as soon as one of the pumps stops or the
+// session times out.
+func (s *Server) OnTraffic(conn *ConnWrapper) Action {
 	_, span := otel.Tracer("gatewayd").Start(s.ctx, "OnTraffic")
 	defer span.End()
 
@@ -293,43 +341,136 @@ func (s *Server) OnTraffic(conn *ConnWrapper, stopConnection chan struct{}) Acti
 	if err != nil {
 		s.Logger.Error().Err(err).Msg("Failed to run OnTraffic hook")
 		span.RecordError(err)
+		if errors.Is(err, gerr.ErrPluginFailedClosed) {
+			return Close
+		}
 	}
 	span.AddEvent("Ran the OnTraffic hooks")
 
 	stack := NewStack()
+	defer stack.Clear()
+
+	// The context is cancelled when the connection is closed, so that the pump
+	// that is still running stops after its current message.
+	pumpCtx, stopPumps := context.WithCancel(context.Background())
+	defer stopPumps()
+
+	// The channel is buffered, so that none of the pumps blocks when it stops,
+	// even if the connection is already being closed.
+	pumpStopped := make(chan *gerr.GatewayDError, 2) //nolint:gomnd
 
 	// Pass the traffic from the client to server.
-	// If there is an error, log it and close the connection.
-	go func(server *Server, conn *ConnWrapper, stopConnection chan struct{}, stack *Stack) {
-		for {
-			server.Logger.Trace().Msg("Passing through traffic from client to server")
-			if err := server.Proxy.PassThroughToServer(conn, stack); err != nil {
-				server.Logger.Trace().Err(err).Msg("Failed to pass through traffic")
-				span.RecordError(err)
-				stopConnection <- struct{}{}
-				break
-			}
+	go s.pump(pumpCtx, "client to server", pumpStopped, func() *gerr.GatewayDError {
+		return s.Proxy.PassThroughToServer(conn, stack)
+	})
+
+	// Pass the traffic from the server to client, including the messages that
+	// the server sends asynchronously, like notifications.
+	go s.pump(pumpCtx, "server to client", pumpStopped, func() *gerr.GatewayDError {
+		return s.Proxy.PassThroughToClient(conn, stack)
+	})
+
+	// Watch the session for timeouts until the connection is closed.
+	sessionTimedOut := s.watchSession(pumpCtx, conn)
+
+	select {
+	case err := <-pumpStopped:
+		if err != nil {
+			span.RecordError(err)
 		}
-	}(s, conn, stopConnection, stack)
+	case timeout := <-sessionTimedOut:
+		span.AddEvent("Session timed out", trace.WithAttributes(
+			attribute.String("timeout", timeout.Name)))
+		s.terminateSession(conn, timeout)
+	}
+
+	return Close
+}
+
+// watchSession checks the session periodically until the context is cancelled and
+// reports the timeout that the session hits to the returned channel. The channel is
+// nil if the timeouts are disabled, so that receiving from it blocks forever.
+func (s *Server) watchSession(ctx context.Context, conn *ConnWrapper) <-chan *sessionTimeout {
+	timeouts := s.sessionTimeouts()
+	if !timeouts.Enabled() {
+		return nil
+	}
+
+	timedOut := make(chan *sessionTimeout, 1)
+	go func() {
+		ticker := time.NewTicker(timeouts.CheckInterval())
+		defer ticker.Stop()
 
-	// Pass the traffic from the server to client.
-	// If there is an error, log it and close the connection.
-	go func(server *Server, conn *ConnWrapper, stopConnection chan struct{}, stack *Stack) {
 		for {
-			server.Logger.Trace().Msg("Passing through traffic from server to client")
-			if err := server.Proxy.PassThroughToClient(conn, stack); err != nil {
-				server.Logger.Trace().Err(err).Msg("Failed to pass through traffic")
-				span.RecordError(err)
-				stopConnection <- struct{}{}
-				break
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if timeout := conn.session.TimedOut(now, timeouts); timeout != nil {
+					timedOut <- timeout
+					return
+				}
 			}
 		}
-	}(s, conn, stopConnection, stack)
+	}()
 
-	<-stopConnection
-	stack.Clear()
+	return timedOut
+}
 
-	return Close
+// terminateSession sends a FATAL error to the client of a session that timed out.
+// The connection is then closed and the server connection is recycled by OnClose.
+func (s *Server) terminateSession(conn *ConnWrapper, timeout *sessionTimeout) {
+	_, span := otel.Tracer("gatewayd").Start(s.ctx, "terminateSession")
+	defer span.End()
+
+	s.Logger.Info().Fields(
+		map[string]interface{}{
+			"timeout": timeout.Name,
+			"local":   LocalAddr(conn.Conn()),
+			"remote":  RemoteAddr(conn.Conn()),
+		},
+	).Msg("Terminating the session due to a timeout")
+	metrics.SessionTimeouts.WithLabelValues(timeout.Name).Inc()
+
+	if err := conn.writeFatal(timeout); err != nil {
+		s.Logger.Debug().Err(err).Msg("Failed to send the timeout error to the client")
+		span.RecordError(err)
+	}
+}
+
+// sessionTimeouts returns the timeouts of the sessions.
+func (s *Server) sessionTimeouts() sessionTimeouts {
+	return sessionTimeouts{
+		Idle:              s.IdleTimeout,
+		IdleInTransaction: s.IdleInTransactionTimeout,
+		MaxDuration:       s.MaxSessionDuration,
+	}
+}
+
+// pump passes the traffic in one direction until the passThrough function fails or
+// the context is cancelled, and then reports the error to the stopped channel.
+func (s *Server) pump(
+	ctx context.Context,
+	direction string,
+	stopped chan<- *gerr.GatewayDError,
+	passThrough func() *gerr.GatewayDError,
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			stopped <- nil
+			return
+		default:
+		}
+
+		s.Logger.Trace().Str("direction", direction).Msg("Passing through traffic")
+		if err := passThrough(); err != nil {
+			s.Logger.Trace().Err(err).Str("direction", direction).Msg(
+				"Failed to pass through traffic")
+			stopped <- err
+			return
+		}
+	}
 }
 
 // OnShutdown is called when the server is shutting down. It calls the OnShutdown hooks.
@@ -359,6 +500,7 @@ func (s *Server) OnShutdown() {
 	s.mu.Lock()
 	s.Status = config.Stopped
 	s.mu.Unlock()
+	s.EventBus.Publish(events.ServerStopped, "server", map[string]interface{}{"server": s.Address})
 }
 
 // OnTick is called every TickInterval. It calls the OnTick hooks.
@@ -526,6 +668,10 @@ func (s *Server) Run() *gerr.GatewayDError {
 				TLSConfig:        tlsConfig,
 				HandshakeTimeout: s.HandshakeTimeout,
 			})
+			// The proxy follows the protocol of the sessions with timeouts.
+			conn.session.tracked = s.sessionTimeouts().Enabled()
+			conn.session.adminDatabase = s.AdminDatabase
+			conn.session.adminConsole = s.AdminConsole
 
 			if out, action := s.OnOpen(conn); action != None {
 				if _, err := conn.Write(out); err != nil {
@@ -541,29 +687,22 @@ func (s *Server) Run() *gerr.GatewayDError {
 			s.connections++
 			s.mu.Unlock()
 
-			// For every new connection, a new unbuffered channel is created to help
-			// stop the proxy, recycle the server connection and close stale connections.
-			stopConnection := make(chan struct{})
-			go func(server *Server, conn *ConnWrapper, stopConnection chan struct{}) {
-				if action := server.OnTraffic(conn, stopConnection); action == Close {
-					stopConnection <- struct{}{}
+			go func(server *Server, conn *ConnWrapper) {
+				if action := server.OnTraffic(conn); action != Close {
+					return
 				}
-			}(s, conn, stopConnection)
-
-			go func(server *Server, conn *ConnWrapper, stopConnection chan struct{}) {
-				for {
-					select {
-					case <-stopConnection:
-						server.mu.Lock()
-						server.connections--
-						server.mu.Unlock()
-						server.OnClose(conn, err)
-						return
-					case <-server.stopServer:
-						return
-					}
+
+				server.mu.Lock()
+				server.connections--
+				server.mu.Unlock()
+
+				// The proxy closes all the connections when the server is shut down.
+				if !server.running.Load() {
+					return
 				}
-			}(s, conn, stopConnection)
+
+				server.OnClose(conn, err)
+			}(s, conn)
 		}
 	}
 }
@@ -580,6 +719,7 @@ func (s *Server) Shutdown() {
 	s.mu.Lock()
 	s.Status = config.Stopped
 	s.mu.Unlock()
+	s.EventBus.Publish(events.ServerStopped, "server", map[string]interface{}{"server": s.Address})
 
 	// Shutdown the server.
 	var err error
@@ -627,24 +767,30 @@ func NewServer(
 
 	// Create the server.
 	server := Server{
-		ctx:              serverCtx,
-		Network:          srv.Network,
-		Address:          srv.Address,
-		Options:          srv.Options,
-		TickInterval:     srv.TickInterval,
-		Status:           config.Stopped,
-		EnableTLS:        srv.EnableTLS,
-		CertFile:         srv.CertFile,
-		KeyFile:          srv.KeyFile,
-		HandshakeTimeout: srv.HandshakeTimeout,
-		Proxy:            srv.Proxy,
-		Logger:           srv.Logger,
-		PluginRegistry:   srv.PluginRegistry,
-		PluginTimeout:    srv.PluginTimeout,
-		mu:               &sync.RWMutex{},
-		connections:      0,
-		running:          &atomic.Bool{},
-		stopServer:       make(chan struct{}),
+		ctx:                      serverCtx,
+		Network:                  srv.Network,
+		Address:                  srv.Address,
+		Options:                  srv.Options,
+		TickInterval:             srv.TickInterval,
+		Status:                   config.Stopped,
+		EnableTLS:                srv.EnableTLS,
+		CertFile:                 srv.CertFile,
+		KeyFile:                  srv.KeyFile,
+		HandshakeTimeout:         srv.HandshakeTimeout,
+		IdleTimeout:              srv.IdleTimeout,
+		IdleInTransactionTimeout: srv.IdleInTransactionTimeout,
+		MaxSessionDuration:       srv.MaxSessionDuration,
+		AdminDatabase:            srv.AdminDatabase,
+		AdminConsole:             srv.AdminConsole,
+		EventBus:                 srv.EventBus,
+		Proxy:                    srv.Proxy,
+		Logger:                   srv.Logger,
+		PluginRegistry:           srv.PluginRegistry,
+		PluginTimeout:            srv.PluginTimeout,
+		mu:                       &sync.RWMutex{},
+		connections:              0,
+		running:                  &atomic.Bool{},
+		stopServer:               make(chan struct{}),
 	}
 
 	// Try to resolve the address and log an error if it can't be resolved.
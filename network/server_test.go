@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net"
 	"os"
 	"sync"
 	"testing"
@@ -16,6 +17,7 @@ import (
 	"github.com/gatewayd-io/gatewayd/logging"
 	"github.com/gatewayd-io/gatewayd/plugin"
 	"github.com/gatewayd-io/gatewayd/pool"
+	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
@@ -252,6 +254,152 @@ func TestRunServer(t *testing.T) {
 	waitGroup.Wait()
 }
 
+// TestServerFullDuplex tests that the messages sent by the server without a request
+// from the client, like notifications, are passed through to the client while a request
+// waits for its response, and that they aren't paired with the request in the hooks.
+func TestServerFullDuplex(t *testing.T) {
+	notification := EncodePgMessages(t, &pgproto3.NotificationResponse{
+		PID:     1,
+		Channel: "events",
+		Payload: "hello",
+	})
+	response := EncodePgMessages(t,
+		&pgproto3.CommandComplete{CommandTag: []byte("SELECT 1")},
+		&pgproto3.ReadyForQuery{TxStatus: 'I'},
+	)
+	query := EncodePgMessages(t, &pgproto3.Query{String: "SELECT 1"})
+
+	backend := StartFakeBackend(t, func(conn net.Conn) {
+		buffer := make([]byte, 1024)
+		// Wait for the query.
+		if _, err := conn.Read(buffer); err != nil {
+			return
+		}
+		// The notification arrives before the response of the query.
+		_, _ = conn.Write(notification)
+		time.Sleep(100 * time.Millisecond)
+		_, _ = conn.Write(response)
+	})
+	server, proxy, pluginRegistry := NewTestServer(t, "127.0.0.1:15433", backend, 1)
+
+	// Record the requests and the responses that the hooks receive.
+	var mu sync.Mutex
+	var requests, responses [][]byte
+	pluginRegistry.AddHook(v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_SERVER, 1,
+		func(_ context.Context, params *v1.Struct, _ ...grpc.CallOption) (*v1.Struct, error) {
+			paramsMap := params.AsMap()
+			mu.Lock()
+			defer mu.Unlock()
+			request, _ := paramsMap["request"].([]byte)
+			response, _ := paramsMap["response"].([]byte)
+			requests = append(requests, request)
+			responses = append(responses, response)
+			return params, nil
+		})
+	RunTestServer(t, server, pluginRegistry)
+
+	client := NewTestClient(t, "127.0.0.1:15433")
+	_, err := client.Send(query)
+	require.Nil(t, err)
+
+	// The client receives the notification while the query waits for its response.
+	size, data, err := client.Receive()
+	require.Nil(t, err)
+	assert.Equal(t, notification, data[:size])
+	assert.Equal(t, 1, proxy.busyConnections.Size())
+
+	size, data, err = client.Receive()
+	require.Nil(t, err)
+	assert.Equal(t, response, data[:size])
+
+	// The notification is passed to the hooks without a request, and the response
+	// is still paired with the query.
+	mu.Lock()
+	assert.Equal(t, [][]byte{{}, query}, requests)
+	assert.Equal(t, [][]byte{notification, response}, responses)
+	mu.Unlock()
+
+	// Closing the client recycles the server connection.
+	client.Close()
+	require.Eventually(t, func() bool {
+		return server.CountConnections() == 0 && proxy.AvailableConnections.Size() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Zero(t, proxy.busyConnections.Size())
+}
+
+// TestServerSessionTimeout tests that the sessions that time out are terminated
+// with a FATAL error and that their server connections are recycled.
+func TestServerSessionTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		txStatus byte
+		timeouts sessionTimeouts
+		expected sessionTimeout
+	}{
+		{
+			name:     "idle",
+			txStatus: 'I',
+			timeouts: sessionTimeouts{Idle: 200 * time.Millisecond, IdleInTransaction: time.Hour},
+			expected: idleTimeout,
+		},
+		{
+			name:     "idle in transaction",
+			txStatus: 'T',
+			timeouts: sessionTimeouts{Idle: time.Hour, IdleInTransaction: 200 * time.Millisecond},
+			expected: idleInTransactionTimeout,
+		},
+		{
+			name:     "max session duration",
+			txStatus: 'I',
+			timeouts: sessionTimeouts{MaxDuration: 500 * time.Millisecond},
+			expected: maxSessionDuration,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backend := StartFakeBackend(t, func(conn net.Conn) {
+				buffer := make([]byte, 1024)
+				// Wait for the query.
+				if _, err := conn.Read(buffer); err != nil {
+					return
+				}
+				_, _ = conn.Write(EncodePgMessages(t,
+					&pgproto3.CommandComplete{CommandTag: []byte("BEGIN")},
+					&pgproto3.ReadyForQuery{TxStatus: test.txStatus},
+				))
+			})
+
+			address := "127.0.0.1:" + []string{"15441", "15442", "15443"}[i]
+			server, proxy, pluginRegistry := NewTestServer(t, address, backend, 1)
+			server.IdleTimeout = test.timeouts.Idle
+			server.IdleInTransactionTimeout = test.timeouts.IdleInTransaction
+			server.MaxSessionDuration = test.timeouts.MaxDuration
+			RunTestServer(t, server, pluginRegistry)
+
+			client := NewTestClient(t, address)
+			_, err := client.Send(EncodePgMessages(t, &pgproto3.Query{String: "BEGIN"}))
+			require.Nil(t, err)
+			size, data, err := client.Receive()
+			require.Nil(t, err)
+			assert.Equal(t, readyForQuery, data[size-6])
+
+			// The client stays idle until the session is terminated.
+			size, data, err = client.Receive()
+			require.Nil(t, err)
+			assert.Equal(t, errorResponse, data[0])
+			assert.Contains(t, string(data[:size]), "FATAL")
+			assert.Contains(t, string(data[:size]), test.expected.Code)
+			assert.Contains(t, string(data[:size]), test.expected.Message)
+
+			require.Eventually(t, func() bool {
+				return server.CountConnections() == 0 && proxy.AvailableConnections.Size() == 1
+			}, 5*time.Second, 10*time.Millisecond)
+			assert.Zero(t, proxy.busyConnections.Size())
+		})
+	}
+}
+
 func onIncomingTraffic(
 	_ context.Context,
 	params *v1.Struct,
@@ -0,0 +1,270 @@
+package network
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// These are the states of the sessions, which are named after
+// the states of the backends in pg_stat_activity.
+const (
+	SessionStartup                  = "startup"
+	SessionActive                   = "active"
+	SessionIdle                     = "idle"
+	SessionIdleInTransaction        = "idle in transaction"
+	SessionIdleInTransactionAborted = "idle in transaction (aborted)"
+	SessionCopyIn                   = "copy in"
+	SessionCopyOut                  = "copy out"
+	// SessionAdmin is the state of the sessions that use the admin console.
+	SessionAdmin = "admin"
+	// SessionRelayed is the state of the sessions whose traffic is relayed as is,
+	// so the proxy doesn't follow their protocol.
+	SessionRelayed = "relayed"
+)
+
+// lastSessionID is the ID of the last session, which is incremented for every new session.
+var lastSessionID atomic.Uint64
+
+// ConnectionInfo is the information about a client connection and its server connection.
+type ConnectionInfo struct {
+	ID                  uint64
+	ClientAddress       string
+	BackendAddress      string
+	BackendLocalAddress string
+	TLS                 bool
+	User                string
+	Database            string
+	OpenedAt            time.Time
+	BytesIn             int64
+	BytesOut            int64
+	State               string
+}
+
+// sessionTimeout is the reason for terminating a session that timed out.
+// The code and the message are sent to the client in a FATAL ErrorResponse.
+type sessionTimeout struct {
+	Name    string
+	Code    string
+	Message string
+}
+
+var (
+	idleTimeout = sessionTimeout{
+		Name:    "idleTimeout",
+		Code:    "57P05",
+		Message: "terminating connection due to idle-session timeout",
+	}
+	idleInTransactionTimeout = sessionTimeout{
+		Name:    "idleInTransactionTimeout",
+		Code:    "25P03",
+		Message: "terminating connection due to idle-in-transaction timeout",
+	}
+	maxSessionDuration = sessionTimeout{
+		Name:    "maxSessionDuration",
+		Code:    "57P01",
+		Message: "terminating connection due to maximum session duration",
+	}
+	// adminShutdown isn't a timeout, but the session is terminated in the same way.
+	adminShutdown = sessionTimeout{
+		Name:    "adminShutdown",
+		Code:    "57P01",
+		Message: "terminating connection due to administrator command",
+	}
+)
+
+// sessionTimeouts are the timeouts of the sessions. A zero timeout is disabled.
+type sessionTimeouts struct {
+	Idle              time.Duration
+	IdleInTransaction time.Duration
+	MaxDuration       time.Duration
+}
+
+// Enabled returns true if any of the timeouts is enabled.
+func (st sessionTimeouts) Enabled() bool {
+	return st.Idle > 0 || st.IdleInTransaction > 0 || st.MaxDuration > 0
+}
+
+// CheckInterval returns how often the sessions are checked, which is a fraction
+// of the shortest timeout, so that the timeouts are enforced without much delay.
+func (st sessionTimeouts) CheckInterval() time.Duration {
+	interval := time.Second
+	for _, timeout := range []time.Duration{st.Idle, st.IdleInTransaction, st.MaxDuration} {
+		if timeout > 0 {
+			interval = min(interval, timeout/4) //nolint:gomnd
+		}
+	}
+	return max(interval, time.Millisecond)
+}
+
+// session keeps the state of the client session on a connection.
+type session struct {
+	id       uint64
+	openedAt time.Time
+	// startupSent is set once the client has sent the startup message,
+	// after which the connection can no longer be upgraded to TLS.
+	startupSent atomic.Bool
+	// tracked is set if the server enforces timeouts on the session, which requires
+	// the proxy to follow the protocol, so the traffic is never relayed as is.
+	tracked  bool
+	protocol *protocolState
+	// relayed is the number of the directions in which the traffic of the session
+	// is relayed as is.
+	relayed atomic.Int32
+	// The clients that connect to the admin database use the admin console,
+	// unless the console is disabled.
+	adminDatabase string
+	adminConsole  IAdminConsole
+
+	// bytesIn and bytesOut are the bytes received from the client and
+	// the bytes received from the server for the client.
+	bytesIn  atomic.Int64
+	bytesOut atomic.Int64
+
+	mu       sync.RWMutex
+	user     string
+	database string
+
+	// closed is closed when the connection is closed.
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// newSession creates a new session for a client connection.
+func newSession() *session {
+	session := &session{
+		id:       lastSessionID.Add(1),
+		openedAt: time.Now(),
+		protocol: newProtocolState(),
+		closed:   make(chan struct{}),
+	}
+	// The server waits for the startup message.
+	session.protocol.waitingSince = session.openedAt
+	return session
+}
+
+// Close marks the session as closed.
+func (s *session) Close() {
+	s.closeOnce.Do(func() { close(s.closed) })
+}
+
+// AtTransactionBoundary returns true if the next request of the client starts
+// a new transaction, i.e. the session is idle outside of a transaction or it
+// hasn't sent the startup message yet.
+func (s *session) AtTransactionBoundary() bool {
+	if !s.startupSent.Load() {
+		return true
+	}
+
+	// The transaction status is stale while the traffic from the server is relayed.
+	if s.relayed.Load() > 0 || s.protocol.CopyDirection() != "" {
+		return false
+	}
+	waitingSince, txStatus := s.protocol.Waiting()
+	return !waitingSince.IsZero() && txStatus == txIdle
+}
+
+// Holdable returns true if the proxy follows the protocol of the session, so that its
+// requests are held at the transaction boundaries while the proxy is paused. The requests
+// of the sessions whose traffic is relayed, or whose transaction status is unknown
+// because their traffic was relayed, pass through until the status is known again.
+func (s *session) Holdable() bool {
+	if s.relayed.Load() > 0 {
+		return false
+	}
+
+	_, txStatus := s.protocol.Waiting()
+	return txStatus != txUnknown
+}
+
+// IsAdmin returns true if the client connected to the admin database.
+func (s *session) IsAdmin() bool {
+	if s.adminConsole == nil || s.adminDatabase == "" {
+		return false
+	}
+
+	_, database := s.Startup()
+	return database == s.adminDatabase
+}
+
+// Started records the user and the database of the session from the startup message.
+func (s *session) Started(request []byte) {
+	parameters := startupParameters(request)
+	if parameters == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.user = parameters["user"]
+	// The database defaults to the user name.
+	s.database = parameters["database"]
+	if s.database == "" {
+		s.database = s.user
+	}
+}
+
+// Startup returns the user and the database of the session.
+func (s *session) Startup() (string, string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.user, s.database
+}
+
+// State returns the current state of the session.
+func (s *session) State() string {
+	if !s.startupSent.Load() {
+		return SessionStartup
+	}
+	if s.IsAdmin() {
+		return SessionAdmin
+	}
+	if s.relayed.Load() > 0 {
+		return SessionRelayed
+	}
+
+	switch s.protocol.CopyDirection() {
+	case CopyIn:
+		return SessionCopyIn
+	case CopyOut:
+		return SessionCopyOut
+	}
+
+	waitingSince, txStatus := s.protocol.Waiting()
+	switch {
+	case waitingSince.IsZero():
+		return SessionActive
+	case txStatus == txInTransaction:
+		return SessionIdleInTransaction
+	case txStatus == txFailed:
+		return SessionIdleInTransactionAborted
+	default:
+		return SessionIdle
+	}
+}
+
+// TimedOut returns the timeout that the session hit at the given time, or nil.
+func (s *session) TimedOut(now time.Time, timeouts sessionTimeouts) *sessionTimeout {
+	if timeouts.MaxDuration > 0 && now.Sub(s.openedAt) >= timeouts.MaxDuration {
+		return &maxSessionDuration
+	}
+
+	waitingSince, txStatus := s.protocol.Waiting()
+	if waitingSince.IsZero() {
+		// The server hasn't answered the client yet.
+		return nil
+	}
+
+	idle := now.Sub(waitingSince)
+	if timeouts.IdleInTransaction > 0 && idle >= timeouts.IdleInTransaction &&
+		(txStatus == txInTransaction || txStatus == txFailed) {
+		return &idleInTransactionTimeout
+	}
+	if timeouts.Idle > 0 && idle >= timeouts.Idle {
+		return &idleTimeout
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSessionTimedOut tests which timeouts a session hits.
+func TestSessionTimedOut(t *testing.T) {
+	timeouts := sessionTimeouts{
+		Idle:              time.Minute,
+		IdleInTransaction: time.Second,
+		MaxDuration:       time.Hour,
+	}
+	assert.True(t, timeouts.Enabled())
+	assert.Equal(t, 250*time.Millisecond, timeouts.CheckInterval())
+	assert.False(t, sessionTimeouts{}.Enabled())
+
+	session := newSession()
+	now := session.openedAt
+
+	// The server waits for the startup message.
+	assert.Nil(t, session.TimedOut(now, timeouts))
+	assert.Equal(t, &idleTimeout, session.TimedOut(now.Add(time.Minute), timeouts))
+
+	// The server doesn't wait for the client until it answers.
+	session.protocol.ClientActive()
+	assert.Nil(t, session.TimedOut(now.Add(time.Minute), timeouts))
+
+	// The client is idle in a transaction.
+	session.protocol.ScanServer(EncodePgMessages(t, &pgproto3.ReadyForQuery{TxStatus: 'T'}))
+	waitingSince, txStatus := session.protocol.Waiting()
+	assert.Equal(t, txInTransaction, txStatus)
+	assert.Nil(t, session.TimedOut(waitingSince, timeouts))
+	assert.Equal(t, &idleInTransactionTimeout,
+		session.TimedOut(waitingSince.Add(time.Second), timeouts))
+
+	// The client is idle outside a transaction.
+	session.protocol.ScanServer(EncodePgMessages(t, &pgproto3.ReadyForQuery{TxStatus: 'I'}))
+	waitingSince, txStatus = session.protocol.Waiting()
+	assert.Equal(t, txIdle, txStatus)
+	assert.Nil(t, session.TimedOut(waitingSince.Add(time.Second), timeouts))
+	assert.Equal(t, &idleTimeout, session.TimedOut(waitingSince.Add(time.Minute), timeouts))
+
+	// The session is too old, even if it is busy.
+	session.protocol.ClientActive()
+	assert.Equal(t, &maxSessionDuration, session.TimedOut(now.Add(time.Hour), timeouts))
+}
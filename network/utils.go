@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net"
+	"sync"
 
 	gerr "github.com/gatewayd-io/gatewayd/errors"
 	"github.com/rs/zerolog"
@@ -21,6 +22,31 @@ func GetID(network, address string, seed int, logger zerolog.Logger) string {
 	return hex.EncodeToString(hash.Sum(nil))
 }
 
+// bufferPool keeps the buffers used for receiving the traffic, so that they are
+// reused instead of being allocated for every read.
+var bufferPool = sync.Pool{
+	New: func() any {
+		return new([]byte)
+	},
+}
+
+// getBuffer returns a buffer of the given size from the pool. The buffer must be
+// returned to the pool by putBuffer once its content is no longer used.
+func getBuffer(size int) *[]byte {
+	buffer, _ := bufferPool.Get().(*[]byte)
+	if cap(*buffer) < size {
+		// The chunk size is configurable, so the pooled buffer might be too small.
+		*buffer = make([]byte, size)
+	}
+	*buffer = (*buffer)[:size]
+	return buffer
+}
+
+// putBuffer returns the buffer to the pool.
+func putBuffer(buffer *[]byte) {
+	bufferPool.Put(buffer)
+}
+
 // Resolve resolves a network address.
 func Resolve(network, address string, logger zerolog.Logger) (string, *gerr.GatewayDError) {
 	switch network {
@@ -0,0 +1,155 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	sdkPlugin "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin"
+	v1 "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin/v1"
+	"github.com/gatewayd-io/gatewayd/config"
+	gerr "github.com/gatewayd-io/gatewayd/errors"
+	"github.com/gatewayd-io/gatewayd/metrics"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// ObservationHooks are the hooks whose results are ignored by the GatewayD, so they can
+// be run in the background without changing the behavior of the GatewayD.
+var ObservationHooks = []v1.HookName{
+	v1.HookName_HOOK_NAME_ON_BOOTED,
+	v1.HookName_HOOK_NAME_ON_OPENED,
+	v1.HookName_HOOK_NAME_ON_CLOSED,
+	v1.HookName_HOOK_NAME_ON_TICK,
+	v1.HookName_HOOK_NAME_ON_TRAFFIC_TO_SERVER,
+	v1.HookName_HOOK_NAME_ON_TRAFFIC_TO_CLIENT,
+}
+
+// asyncCall is a call to the hooks that is queued to be run in the background.
+type asyncCall struct {
+	hookName v1.HookName
+	params   *v1.Struct
+	opts     []grpc.CallOption
+}
+
+// ParseAsyncHooks returns the hooks with the given names, which must be observation hooks.
+func ParseAsyncHooks(names []string) ([]v1.HookName, *gerr.GatewayDError) {
+	hookNames := make([]v1.HookName, 0, len(names))
+	for _, name := range names {
+		value, ok := v1.HookName_value[name]
+		if !ok {
+			return nil, gerr.ErrValidationFailed.Wrap(fmt.Errorf("unknown hook %q", name))
+		}
+		hookName := v1.HookName(value)
+		if !slices.Contains(ObservationHooks, hookName) {
+			return nil, gerr.ErrValidationFailed.Wrap(
+				fmt.Errorf("the results of the %s hooks are used, so they can't run async", name))
+		}
+		hookNames = append(hookNames, hookName)
+	}
+	return hookNames, nil
+}
+
+// startAsyncWorkers starts the workers that run the async hooks.
+func (reg *Registry) startAsyncWorkers() {
+	if reg.AsyncWorkers <= 0 {
+		reg.AsyncWorkers = config.DefaultAsyncHookWorkers
+	}
+	if reg.AsyncQueueSize <= 0 {
+		reg.AsyncQueueSize = config.DefaultAsyncHookQueueSize
+	}
+	if reg.PluginTimeout <= 0 {
+		reg.PluginTimeout = config.DefaultPluginTimeout
+	}
+
+	reg.asyncQueue = make(chan asyncCall, reg.AsyncQueueSize)
+	for range reg.AsyncWorkers {
+		reg.asyncWorkers.Add(1)
+		go func() {
+			defer reg.asyncWorkers.Done()
+			for call := range reg.asyncQueue {
+				reg.runAsync(call)
+			}
+		}()
+	}
+}
+
+// IsAsync returns true if the hooks of the type are run in parallel in the background.
+func (reg *Registry) IsAsync(hookName v1.HookName) bool {
+	return reg.asyncQueue != nil && slices.Contains(reg.AsyncHooks, hookName)
+}
+
+// enqueueAsync queues the call to the hooks without waiting for the queue. The call is
+// dropped if the queue is full or the registry is shut down.
+func (reg *Registry) enqueueAsync(hookName v1.HookName, params *v1.Struct, opts []grpc.CallOption) {
+	// The params may refer to buffers that are reused after the hooks return.
+	call := asyncCall{
+		hookName: hookName,
+		params:   proto.Clone(params).(*v1.Struct), //nolint:forcetypeassert
+		opts:     opts,
+	}
+
+	reg.asyncMu.RLock()
+	defer reg.asyncMu.RUnlock()
+
+	if !reg.asyncClosed {
+		select {
+		case reg.asyncQueue <- call:
+			return
+		default:
+		}
+	}
+
+	metrics.PluginAsyncHooksDropped.Inc()
+	reg.Logger.Trace().Str("hookName", hookName.String()).Msg(
+		"Dropped the async hook call, because the queue is full")
+}
+
+// runAsync calls the hooks in parallel, since their results aren't passed to each other.
+// The policies are still applied to the results, e.g. to log the signals.
+func (reg *Registry) runAsync(call asyncCall) {
+	_, span := otel.Tracer(config.TracerName).Start(reg.ctx, "RunAsync")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(context.Background(), reg.PluginTimeout)
+	defer cancel()
+
+	methods, _, release := reg.acquireHooks(call.hookName)
+	defer release()
+
+	var wait sync.WaitGroup
+	for priority, method := range methods {
+		wait.Add(1)
+		go func(priority sdkPlugin.Priority, method sdkPlugin.Method) {
+			defer wait.Done()
+
+			// The failure mode is ignored, since the traffic has already been passed on.
+			result, _ := reg.callHook(ctx, call.hookName, priority, method, call.params, call.opts...)
+			if result == nil {
+				return
+			}
+
+			reg.Apply(call.hookName.String(), result)
+		}(priority, method)
+	}
+	wait.Wait()
+}
+
+// stopAsyncWorkers stops accepting async hook calls and waits for the workers
+// to run the queued calls.
+func (reg *Registry) stopAsyncWorkers() {
+	if reg.asyncQueue == nil {
+		return
+	}
+
+	reg.asyncMu.Lock()
+	if !reg.asyncClosed {
+		reg.asyncClosed = true
+		close(reg.asyncQueue)
+	}
+	reg.asyncMu.Unlock()
+
+	reg.asyncWorkers.Wait()
+}
@@ -0,0 +1,192 @@
+package plugin
+
+import (
+	"context"
+	"sync"
+
+	sdkPlugin "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin"
+	v1 "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin/v1"
+	"google.golang.org/grpc"
+)
+
+// BuiltinPlugin is a plugin that is compiled into the gatewayd binary. It implements the
+// same service as the plugins that run as subprocesses, so a plugin can be built either
+// way, but its hooks are called in-process, without serializing their arguments and results.
+// The metadata of the plugin, including its name and hooks, is returned by GetPluginConfig.
+type BuiltinPlugin struct {
+	// Priority is the priority of the hooks of the plugin, which must be below
+	// config.PluginPriorityStart, because the higher priorities are used by the
+	// plugins in the config file.
+	Priority sdkPlugin.Priority
+	Impl     v1.GatewayDPluginServiceServer
+}
+
+var (
+	builtinPlugins   []BuiltinPlugin
+	builtinPluginsMu sync.Mutex
+)
+
+// RegisterBuiltinPlugin registers a built-in plugin to be loaded by gatewayd. It is
+// usually called in the init function of the package of the plugin.
+func RegisterBuiltinPlugin(plugin BuiltinPlugin) {
+	builtinPluginsMu.Lock()
+	defer builtinPluginsMu.Unlock()
+
+	builtinPlugins = append(builtinPlugins, plugin)
+}
+
+// BuiltinPlugins returns the registered built-in plugins.
+func BuiltinPlugins() []BuiltinPlugin {
+	builtinPluginsMu.Lock()
+	defer builtinPluginsMu.Unlock()
+
+	plugins := make([]BuiltinPlugin, len(builtinPlugins))
+	copy(plugins, builtinPlugins)
+	return plugins
+}
+
+// builtinClient calls the methods of a built-in plugin in-process. The call options
+// are only used by gRPC, so they are ignored.
+type builtinClient struct {
+	server v1.GatewayDPluginServiceServer
+}
+
+var _ v1.GatewayDPluginServiceClient = (*builtinClient)(nil)
+
+func (c *builtinClient) GetPluginConfig(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.GetPluginConfig(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnConfigLoaded(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnConfigLoaded(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnNewLogger(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnNewLogger(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnNewPool(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnNewPool(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnNewClient(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnNewClient(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnNewProxy(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnNewProxy(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnNewServer(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnNewServer(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnSignal(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnSignal(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnRun(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnRun(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnBooting(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnBooting(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnBooted(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnBooted(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnOpening(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnOpening(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnOpened(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnOpened(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnClosing(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnClosing(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnClosed(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnClosed(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnTraffic(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnTraffic(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnTrafficFromClient(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnTrafficFromClient(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnTrafficToServer(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnTrafficToServer(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnTrafficFromServer(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnTrafficFromServer(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnTrafficToClient(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnTrafficToClient(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnShutdown(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnShutdown(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnTick(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnTick(ctx, in) //nolint:wrapcheck
+}
+
+func (c *builtinClient) OnHook(
+	ctx context.Context, in *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	return c.server.OnHook(ctx, in) //nolint:wrapcheck
+}
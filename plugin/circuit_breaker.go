@@ -0,0 +1,132 @@
+package plugin
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/gatewayd-io/gatewayd/metrics"
+)
+
+type BreakerState string
+
+// These are the states of a circuit breaker. The breaker is closed while the plugin works,
+// opens after too many consecutive failures and is half-open once the cool-down is over,
+// which lets a single call through to check whether the plugin works again.
+const (
+	BreakerClosed   BreakerState = "closed"
+	BreakerOpen     BreakerState = "open"
+	BreakerHalfOpen BreakerState = "half-open"
+)
+
+// breakerStateValues are the values of the circuit breaker state metric.
+var breakerStateValues = map[BreakerState]float64{
+	BreakerClosed:   0,
+	BreakerHalfOpen: 1,
+	BreakerOpen:     2,
+}
+
+// CircuitBreaker skips the hooks of a plugin after FailureThreshold consecutive failures
+// for the CoolDown period, so that a failing plugin doesn't delay the traffic.
+type CircuitBreaker struct {
+	Name             string
+	FailureThreshold int
+	CoolDown         time.Duration
+
+	mu       *sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewCircuitBreaker creates a closed circuit breaker for the named plugin.
+func NewCircuitBreaker(breaker CircuitBreaker) *CircuitBreaker {
+	if breaker.FailureThreshold <= 0 {
+		breaker.FailureThreshold = config.DefaultBreakerFailureThreshold
+	}
+	if breaker.CoolDown <= 0 {
+		breaker.CoolDown = config.DefaultBreakerCoolDown
+	}
+
+	cb := &CircuitBreaker{
+		Name:             breaker.Name,
+		FailureThreshold: breaker.FailureThreshold,
+		CoolDown:         breaker.CoolDown,
+		mu:               &sync.Mutex{},
+	}
+	cb.setState(BreakerClosed)
+	return cb
+}
+
+// Allow returns true if the hook can be called. Only one call is let through while
+// the breaker is half-open.
+func (cb *CircuitBreaker) Allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case BreakerOpen:
+		if time.Since(cb.openedAt) < cb.CoolDown {
+			return false
+		}
+		cb.setState(BreakerHalfOpen)
+		cb.probing = true
+		return true
+	case BreakerHalfOpen:
+		if cb.probing {
+			return false
+		}
+		cb.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// Success closes the breaker and resets the failures.
+func (cb *CircuitBreaker) Success() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.failures = 0
+	cb.probing = false
+	if cb.state != BreakerClosed {
+		cb.setState(BreakerClosed)
+	}
+}
+
+// Failure counts the failure and returns true if it opened the breaker.
+func (cb *CircuitBreaker) Failure() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.failures++
+	cb.probing = false
+	if cb.state == BreakerHalfOpen ||
+		(cb.state == BreakerClosed && cb.failures >= cb.FailureThreshold) {
+		cb.openedAt = time.Now()
+		cb.setState(BreakerOpen)
+		return true
+	}
+	return false
+}
+
+// State returns the state of the breaker and the number of consecutive failures.
+func (cb *CircuitBreaker) State() (BreakerState, int) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	// The breaker is only half-open once a call is allowed, but it is reported as
+	// half-open as soon as the cool-down is over.
+	if cb.state == BreakerOpen && time.Since(cb.openedAt) >= cb.CoolDown {
+		return BreakerHalfOpen, cb.failures
+	}
+	return cb.state, cb.failures
+}
+
+// setState changes the state and its metric. The caller must hold the lock.
+func (cb *CircuitBreaker) setState(state BreakerState) {
+	cb.state = state
+	metrics.PluginCircuitBreakerState.WithLabelValues(cb.Name).Set(breakerStateValues[state])
+}
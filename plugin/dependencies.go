@@ -0,0 +1,184 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	sdkPlugin "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin"
+)
+
+// UnsatisfiedPlugin is a plugin that isn't loaded, because its requirements are not met.
+type UnsatisfiedPlugin struct {
+	Name    string
+	Reasons []string
+}
+
+// requiredVersion parses the version of a requirement. It is a semver range, e.g. "^1.2.0"
+// or ">= 1.0, < 2.0". A bare version is the minimum version, and an empty one or "latest"
+// matches any version.
+func requiredVersion(version string) (*semver.Constraints, error) {
+	version = strings.TrimSpace(version)
+	switch version {
+	case "", "*", "latest":
+		return semver.NewConstraint("*") //nolint:wrapcheck
+	}
+
+	if _, err := semver.StrictNewVersion(strings.TrimPrefix(version, "v")); err == nil {
+		version = ">= " + version
+	}
+	return semver.NewConstraint(version) //nolint:wrapcheck
+}
+
+// satisfiesVersion returns true if the version is in the required range.
+func satisfiesVersion(required, version string) (bool, error) {
+	constraint, err := requiredVersion(required)
+	if err != nil {
+		return false, fmt.Errorf("invalid version range %q: %w", required, err)
+	}
+
+	parsed, err := semver.NewVersion(version)
+	if err != nil {
+		return false, fmt.Errorf("invalid version %q: %w", version, err)
+	}
+
+	return constraint.Check(parsed), nil
+}
+
+// sortByRequirements sorts the plugins, so that each plugin comes after the plugins it
+// requires. The plugins that don't depend on each other keep their order. The plugins in
+// a dependency cycle, and the plugins that require them, are added at the end in their
+// original order, and the cycles are returned by the names of the plugins in them.
+func sortByRequirements(plugins []*Plugin) ([]*Plugin, map[string][]string) {
+	byName := make(map[string]*Plugin, len(plugins))
+	for _, plugin := range plugins {
+		byName[plugin.ID.Name] = plugin
+	}
+
+	// The requirements that are not in the list can't be sorted, so they are reported
+	// when the plugins are loaded.
+	requires := func(plugin *Plugin) []string {
+		var names []string
+		for _, req := range plugin.Requires {
+			if _, ok := byName[req.Name]; ok && req.Name != plugin.ID.Name {
+				names = append(names, req.Name)
+			}
+		}
+		return names
+	}
+
+	sorted := make([]*Plugin, 0, len(plugins))
+	added := make(map[string]bool, len(plugins))
+	for len(sorted) < len(plugins) {
+		progress := false
+		for _, plugin := range plugins {
+			if added[plugin.ID.Name] {
+				continue
+			}
+
+			ready := true
+			for _, name := range requires(plugin) {
+				if !added[name] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				sorted = append(sorted, plugin)
+				added[plugin.ID.Name] = true
+				progress = true
+				// Start over, so that the plugins keep their order when possible.
+				break
+			}
+		}
+		if !progress {
+			break
+		}
+	}
+
+	cycles := make(map[string][]string)
+	for _, plugin := range plugins {
+		if added[plugin.ID.Name] {
+			continue
+		}
+
+		// Follow the requirements that are not added until a plugin is visited twice.
+		path := []string{plugin.ID.Name}
+		visited := map[string]int{plugin.ID.Name: 0}
+		for current := plugin; ; {
+			var next *Plugin
+			for _, name := range requires(current) {
+				if !added[name] {
+					next = byName[name]
+					break
+				}
+			}
+			if next == nil {
+				break
+			}
+			if start, ok := visited[next.ID.Name]; ok {
+				cycle := append(path[start:len(path):len(path)], next.ID.Name)
+				for _, name := range path[start:] {
+					if _, ok := cycles[name]; !ok {
+						cycles[name] = cycle
+					}
+				}
+				break
+			}
+			visited[next.ID.Name] = len(path)
+			path = append(path, next.ID.Name)
+			current = next
+		}
+
+		sorted = append(sorted, plugin)
+	}
+
+	return sorted, cycles
+}
+
+// unmetRequirements returns the reasons why the requirements of the plugin are not met
+// by the plugins in the registry. The unavailable plugins are the plugins that can't be
+// loaded, and the reasons why.
+func (reg *Registry) unmetRequirements(plugin *Plugin, unavailable map[string]string) []string {
+	var reasons []string
+	for _, req := range plugin.Requires {
+		if reason := reg.unmetRequirement(req, unavailable); reason != "" {
+			reasons = append(reasons, reason)
+		}
+	}
+	return reasons
+}
+
+// unmetRequirement returns the reason why the requirement isn't met, or an empty string.
+func (reg *Registry) unmetRequirement(
+	req sdkPlugin.Identifier, unavailable map[string]string,
+) string {
+	var required *Plugin
+	reg.ForEach(func(_ sdkPlugin.Identifier, plugin *Plugin) {
+		if plugin.ID.Name == req.Name {
+			required = plugin
+		}
+	})
+
+	if required == nil {
+		if reason, ok := unavailable[req.Name]; ok {
+			return fmt.Sprintf("requires %q, which %s", req.Name, reason)
+		}
+		return fmt.Sprintf("requires %q, which isn't loaded", req.Name)
+	}
+
+	if req.RemoteURL != "" && required.ID.RemoteURL != req.RemoteURL {
+		return fmt.Sprintf("requires %q from %s, but it is from %s",
+			req.Name, req.RemoteURL, required.ID.RemoteURL)
+	}
+
+	ok, err := satisfiesVersion(req.Version, required.ID.Version)
+	if err != nil {
+		return fmt.Sprintf("requires %q: %s", req.Name, err)
+	}
+	if !ok {
+		return fmt.Sprintf("requires %q %s, but the version is %s",
+			req.Name, req.Version, required.ID.Version)
+	}
+	return ""
+}
@@ -0,0 +1,239 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	sdkAct "github.com/gatewayd-io/gatewayd-plugin-sdk/act"
+	"github.com/gatewayd-io/gatewayd-plugin-sdk/databases/postgres"
+	sdkPlugin "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin"
+	v1 "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin/v1"
+	"github.com/gatewayd-io/gatewayd/config"
+	gerr "github.com/gatewayd-io/gatewayd/errors"
+	"github.com/gatewayd-io/gatewayd/metrics"
+	"google.golang.org/grpc"
+)
+
+// expressionHook is a hook that is defined by an expression in the config. The expression
+// gets the hook name, the payload and the query of the request, if any, and returns nil,
+// a list of signals, or a map with the signals and the fields that replace the fields of
+// the payload, e.g. {"signals": [terminate()], "fields": {"error": "blocked"}}.
+type expressionHook struct {
+	name     string
+	hookName v1.HookName
+	priority sdkPlugin.Priority
+	program  *vm.Program
+}
+
+// newExpressionHook compiles the expression of the hook.
+func newExpressionHook(hookConfig config.ExpressionHook) (*expressionHook, error) {
+	if hookConfig.Name == "" {
+		return nil, errors.New("the name of the expression hook is not set") //nolint:goerr113
+	}
+
+	value, ok := v1.HookName_value[hookConfig.Hook]
+	if !ok || v1.HookName(value) == v1.HookName_HOOK_NAME_UNSPECIFIED {
+		return nil, fmt.Errorf("%q: unknown hook %q", hookConfig.Name, hookConfig.Hook) //nolint:goerr113
+	}
+	hookName := v1.HookName(value)
+
+	program, err := expr.Compile(
+		hookConfig.Expression, expr.Env(expressionEnv(hookName, map[string]any{})))
+	if err != nil {
+		return nil, fmt.Errorf("%q: invalid expression: %w", hookConfig.Name, err)
+	}
+
+	return &expressionHook{
+		name:     hookConfig.Name,
+		hookName: hookName,
+		priority: sdkPlugin.Priority(hookConfig.Priority),
+		program:  program,
+	}, nil
+}
+
+// ValidateExpressionHooks checks that the hooks of the expression hooks exist and that
+// their expressions compile.
+func ValidateExpressionHooks(hooks []config.ExpressionHook) *gerr.GatewayDError {
+	var errs []error
+	for _, hookConfig := range hooks {
+		if _, err := newExpressionHook(hookConfig); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return gerr.ErrValidationFailed.Wrap(errors.Join(errs...))
+	}
+	return nil
+}
+
+// expressionEnv returns the variables and the functions of the expressions.
+func expressionEnv(hookName v1.HookName, payload map[string]any) map[string]any {
+	query := ""
+	if request, ok := payload["request"].([]byte); ok {
+		// The query is empty if the request isn't a query.
+		query, _ = postgres.GetQueryFromRequest(request)
+	}
+
+	return map[string]any{
+		"hook":    hookName.String(),
+		"payload": payload,
+		"query":   query,
+		"signal": func(name string, metadata ...map[string]any) map[string]any {
+			signal := sdkAct.Signal{Name: name}
+			if len(metadata) > 0 {
+				signal.Metadata = metadata[0]
+			}
+			return signal.ToMap()
+		},
+		"terminate": func() map[string]any {
+			return sdkAct.Terminate().ToMap()
+		},
+		"log": func(level, message string) map[string]any {
+			return sdkAct.Log(level, message, nil).ToMap()
+		},
+		"inSubnet": inSubnet,
+	}
+}
+
+// inSubnet returns true if the IP address, which can have a port, is in the subnet.
+func inSubnet(address, subnet string) bool {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return false
+	}
+	prefix, err := netip.ParsePrefix(subnet)
+	if err != nil {
+		return false
+	}
+	return prefix.Contains(addr.Unmap())
+}
+
+// call evaluates the expression with the payload and returns the payload with the
+// signals and the fields that the expression returned.
+func (h *expressionHook) call(
+	ctx context.Context, params *v1.Struct, _ ...grpc.CallOption,
+) (*v1.Struct, error) {
+	payload := params.AsMap()
+	// The signals of the previous hooks are already applied.
+	delete(payload, sdkAct.Signals)
+
+	output, err := h.eval(ctx, expressionEnv(h.hookName, payload))
+	if err != nil {
+		return nil, err
+	}
+
+	switch out := output.(type) {
+	case nil:
+	case []any:
+		payload[sdkAct.Signals] = out
+	case map[string]any:
+		for key, value := range out {
+			switch key {
+			case "signals":
+				signals, ok := value.([]any)
+				if !ok {
+					return nil, fmt.Errorf( //nolint:goerr113
+						"the signals must be a list, but they are %T", value)
+				}
+				payload[sdkAct.Signals] = signals
+			case "fields":
+				fields, ok := value.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf( //nolint:goerr113
+						"the fields must be a map, but they are %T", value)
+				}
+				for name, field := range fields {
+					payload[name] = field
+				}
+			default:
+				return nil, fmt.Errorf( //nolint:goerr113
+					"unknown key %q in the result, expected signals or fields", key)
+			}
+		}
+	default:
+		return nil, fmt.Errorf( //nolint:goerr113
+			"the expression must return nil, a list of signals or a map, but it returned %T", output)
+	}
+
+	result, err := v1.NewStruct(castToPrimitiveTypes(payload))
+	if err != nil {
+		return nil, gerr.ErrCastFailed.Wrap(err)
+	}
+	return result, nil
+}
+
+// eval runs the expression until it returns or the context is done.
+func (h *expressionHook) eval(ctx context.Context, env map[string]any) (any, error) {
+	type evalResult struct {
+		output any
+		err    error
+	}
+	done := make(chan evalResult, 1)
+	go func() {
+		output, err := expr.Run(h.program, env)
+		done <- evalResult{output, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err() //nolint:wrapcheck
+	case result := <-done:
+		if result.err != nil {
+			return nil, gerr.ErrEvalError.Wrap(result.err)
+		}
+		return result.output, nil
+	}
+}
+
+// LoadExpressionHooks compiles the expression hooks and registers them with their
+// priorities, which must not be used by the plugins. The calls to the expression hooks
+// have the default timeout and circuit breaker of the plugins, and they fail open.
+func (reg *Registry) LoadExpressionHooks(hooks []config.ExpressionHook) *gerr.GatewayDError {
+	var errs []error
+	for _, hookConfig := range hooks {
+		hook, err := newExpressionHook(hookConfig)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		settings, err := newPluginSettings(config.Plugin{Name: hook.name})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		reg.hooksMu.Lock()
+		if other, ok := reg.settings[hook.priority]; ok {
+			reg.hooksMu.Unlock()
+			errs = append(errs, fmt.Errorf( //nolint:goerr113
+				"%q: the priority %d is taken by %q", hook.name, hook.priority, other.name))
+			continue
+		}
+		reg.settings[hook.priority] = settings
+		reg.hooksMu.Unlock()
+
+		reg.AddHook(hook.hookName, hook.priority, hook.call)
+		metrics.PluginHooksRegistered.Inc()
+		reg.Logger.Debug().Fields(map[string]any{
+			"name":     hook.name,
+			"hook":     hook.hookName.String(),
+			"priority": hook.priority,
+		}).Msg("Registered expression hook")
+	}
+
+	if len(errs) > 0 {
+		return gerr.ErrValidationFailed.Wrap(errors.Join(errs...))
+	}
+	return nil
+}
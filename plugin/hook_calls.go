@@ -0,0 +1,180 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sdkPlugin "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin"
+	v1 "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin/v1"
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/gatewayd-io/gatewayd/metrics"
+	"google.golang.org/grpc"
+)
+
+// closableHooks are the hooks whose callers close the connection of the client, or don't
+// add the server connection to the pool, if a plugin fails closed. The other hooks, e.g.
+// OnBooting or OnTick, have no traffic to terminate, so the plugins always fail open.
+var closableHooks = map[v1.HookName]bool{
+	v1.HookName_HOOK_NAME_ON_OPENING:             true,
+	v1.HookName_HOOK_NAME_ON_OPENED:              true,
+	v1.HookName_HOOK_NAME_ON_TRAFFIC:             true,
+	v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT: true,
+	v1.HookName_HOOK_NAME_ON_TRAFFIC_TO_SERVER:   true,
+	v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_SERVER: true,
+	v1.HookName_HOOK_NAME_ON_TRAFFIC_TO_CLIENT:   true,
+	v1.HookName_HOOK_NAME_ON_NEW_CLIENT:          true,
+}
+
+// pluginSettings are the timeouts and the failure handling of the hooks of a plugin.
+type pluginSettings struct {
+	name         string
+	timeout      time.Duration
+	hookTimeouts map[v1.HookName]time.Duration
+	failureMode  config.FailureMode
+	breaker      *CircuitBreaker
+	// hookPriorities override the priority of the plugin for the given hooks.
+	hookPriorities map[v1.HookName]sdkPlugin.Priority
+}
+
+// newPluginSettings returns the settings of the hooks of the plugin in the config.
+func newPluginSettings(pCfg config.Plugin) (*pluginSettings, error) {
+	failureMode := config.FailureMode(pCfg.FailureMode)
+	switch failureMode {
+	case "":
+		failureMode = config.DefaultFailureMode
+	case config.FailOpen, config.FailClosed:
+	default:
+		return nil, fmt.Errorf("invalid failure mode %q, expected %s or %s", //nolint:goerr113
+			pCfg.FailureMode, config.FailOpen, config.FailClosed)
+	}
+
+	hookTimeouts := make(map[v1.HookName]time.Duration, len(pCfg.HookTimeouts))
+	for name, timeout := range pCfg.HookTimeouts {
+		value, ok := v1.HookName_value[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown hook %q in the hook timeouts", name) //nolint:goerr113
+		}
+		hookTimeouts[v1.HookName(value)] = timeout
+	}
+
+	hookPriorities, err := HookPriorities(pCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pluginSettings{
+		name:           pCfg.Name,
+		timeout:        pCfg.Timeout,
+		hookTimeouts:   hookTimeouts,
+		failureMode:    failureMode,
+		hookPriorities: hookPriorities,
+		breaker: NewCircuitBreaker(CircuitBreaker{
+			Name:             pCfg.Name,
+			FailureThreshold: pCfg.CircuitBreaker.FailureThreshold,
+			CoolDown:         pCfg.CircuitBreaker.CoolDown,
+		}),
+	}, nil
+}
+
+// hookTimeout returns the timeout of the calls to the hook, or zero if they are only
+// limited by the context of the caller, which has the global plugin timeout.
+func (s *pluginSettings) hookTimeout(hookName v1.HookName) time.Duration {
+	if timeout, ok := s.hookTimeouts[hookName]; ok && timeout > 0 {
+		return timeout
+	}
+	return s.timeout
+}
+
+// CircuitBreaker returns the circuit breaker of the plugin and its failure mode,
+// or nil if the plugin isn't loaded from the config.
+func (reg *Registry) CircuitBreaker(pluginID sdkPlugin.Identifier) (*CircuitBreaker, config.FailureMode) {
+	plugin := reg.Get(pluginID)
+	if plugin == nil {
+		return nil, ""
+	}
+
+	reg.hooksMu.RLock()
+	defer reg.hooksMu.RUnlock()
+
+	if settings, ok := reg.settings[plugin.Priority]; ok {
+		return settings.breaker, settings.failureMode
+	}
+	return nil, ""
+}
+
+// callHook calls the hook with the timeout of its plugin, unless the circuit breaker of
+// the plugin is open. The call fails if the hook returns an error or a nil result, or if
+// it is skipped. It returns the result of the hook, which is nil if the call failed, and
+// true if the plugin fails closed on one of the closableHooks, so the traffic must be terminated.
+func (reg *Registry) callHook(
+	ctx context.Context,
+	hookName v1.HookName,
+	priority sdkPlugin.Priority,
+	method sdkPlugin.Method,
+	params *v1.Struct,
+	opts ...grpc.CallOption,
+) (*v1.Struct, bool) {
+	reg.hooksMu.RLock()
+	settings := reg.settings[priority]
+	reg.hooksMu.RUnlock()
+
+	fields := map[string]any{
+		"hookName": hookName.String(),
+		"priority": priority,
+	}
+
+	// The hooks that are added without a plugin, e.g. in the tests, are always called.
+	if settings == nil {
+		result, err := method(ctx, params, opts...)
+		if err != nil {
+			reg.Logger.Error().Err(err).Fields(fields).Msg("Hook returned an error")
+			return nil, false
+		}
+		if result == nil {
+			reg.Logger.Error().Fields(fields).Msg(
+				"Hook returned nil result, so it won't work properly")
+		}
+		return result, false
+	}
+
+	fields["plugin"] = settings.name
+	failClosed := settings.failureMode == config.FailClosed && closableHooks[hookName]
+
+	if !settings.breaker.Allow() {
+		metrics.PluginHookFailures.WithLabelValues(settings.name, hookName.String()).Inc()
+		reg.Logger.Debug().Fields(fields).Msg(
+			"The circuit breaker of the plugin is open, so the hook is skipped")
+		return nil, failClosed
+	}
+
+	if timeout := settings.hookTimeout(hookName); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	result, err := method(ctx, params, opts...)
+	if err == nil && result != nil {
+		settings.breaker.Success()
+		return result, false
+	}
+
+	metrics.PluginHookFailures.WithLabelValues(settings.name, hookName.String()).Inc()
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		reg.Logger.Error().Err(err).Fields(fields).Msg("Hook timed out")
+	case err != nil:
+		reg.Logger.Error().Err(err).Fields(fields).Msg("Hook returned an error")
+	default:
+		reg.Logger.Error().Fields(fields).Msg("Hook returned nil result")
+	}
+
+	if settings.breaker.Failure() {
+		reg.Logger.Warn().Fields(fields).Msgf(
+			"The circuit breaker of the plugin is open, so its hooks are skipped for %s",
+			settings.breaker.CoolDown)
+	}
+	return nil, failClosed
+}
@@ -0,0 +1,144 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/docker/go-units"
+	"github.com/gatewayd-io/gatewayd/config"
+)
+
+// isolation limits the resources and the privileges of a plugin subprocess.
+type isolation struct {
+	name        string
+	settings    config.PluginIsolation
+	memoryLimit int64
+	// uid and gid are -1 if the plugin runs as the user and the group of GatewayD.
+	uid int
+	gid int
+	// cgroup is the open cgroup directory the plugin is started in, if any.
+	cgroup *os.File
+	// cgroupDir is the path of the cgroup directory, which is removed if the plugin fails to start.
+	cgroupDir string
+}
+
+// newIsolation parses and validates the isolation settings of the plugin.
+func newIsolation(name string, settings config.PluginIsolation) (*isolation, error) {
+	iso := &isolation{name: name, settings: settings, uid: -1, gid: -1}
+
+	if settings.MemoryLimit != "" {
+		memoryLimit, err := units.RAMInBytes(settings.MemoryLimit)
+		if err != nil || memoryLimit <= 0 {
+			return nil, fmt.Errorf("invalid memory limit %q", settings.MemoryLimit) //nolint:goerr113
+		}
+		iso.memoryLimit = memoryLimit
+	}
+
+	if settings.CPULimit < 0 {
+		return nil, fmt.Errorf("invalid CPU limit %v", settings.CPULimit) //nolint:goerr113
+	}
+	if settings.CPULimit > 0 && settings.Cgroup == "" {
+		return nil, errors.New("the CPU limit requires a cgroup") //nolint:goerr113
+	}
+
+	if settings.User != "" {
+		account, err := lookupUser(settings.User)
+		if err != nil {
+			return nil, err
+		}
+		if iso.uid, err = strconv.Atoi(account.Uid); err != nil {
+			return nil, fmt.Errorf("invalid user ID %q: %w", account.Uid, err)
+		}
+		if iso.gid, err = strconv.Atoi(account.Gid); err != nil {
+			return nil, fmt.Errorf("invalid group ID %q: %w", account.Gid, err)
+		}
+	}
+
+	if settings.Group != "" {
+		group, err := lookupGroup(settings.Group)
+		if err != nil {
+			return nil, err
+		}
+		if iso.gid, err = strconv.Atoi(group.Gid); err != nil {
+			return nil, fmt.Errorf("invalid group ID %q: %w", group.Gid, err)
+		}
+	}
+
+	return iso, nil
+}
+
+// lookupUser looks up the user by its name or ID.
+func lookupUser(name string) (*user.User, error) {
+	account, err := user.Lookup(name)
+	if err == nil {
+		return account, nil
+	}
+	if account, idErr := user.LookupId(name); idErr == nil {
+		return account, nil
+	}
+	return nil, fmt.Errorf("unknown user %q: %w", name, err)
+}
+
+// lookupGroup looks up the group by its name or ID.
+func lookupGroup(name string) (*user.Group, error) {
+	group, err := user.LookupGroup(name)
+	if err == nil {
+		return group, nil
+	}
+	if group, idErr := user.LookupGroupId(name); idErr == nil {
+		return group, nil
+	}
+	return nil, fmt.Errorf("unknown group %q: %w", name, err)
+}
+
+// skipHostEnv returns true if the environment of GatewayD is filtered by the allowlist,
+// so it must not be passed to the plugin by go-plugin.
+func (i *isolation) skipHostEnv() bool {
+	return len(i.settings.EnvAllowlist) > 0
+}
+
+// apply sets the working directory, the environment and the privileges of the command,
+// and creates the cgroup of the plugin if it is set.
+func (i *isolation) apply(command *exec.Cmd) error {
+	if i.settings.WorkingDir != "" {
+		// The path of the command is relative to its directory, if it is relative.
+		path, err := filepath.Abs(command.Path)
+		if err != nil {
+			return fmt.Errorf("failed to get the absolute path of the plugin: %w", err)
+		}
+		command.Path = path
+		command.Dir = i.settings.WorkingDir
+	}
+
+	if i.skipHostEnv() {
+		command.Env = append(filterEnv(os.Environ(), i.settings.EnvAllowlist), command.Env...)
+	}
+
+	return i.applyPlatform(command)
+}
+
+// filterEnv returns the environment variables whose names are in the allowlist.
+func filterEnv(env, allowlist []string) []string {
+	filtered := make([]string, 0, len(allowlist))
+	for _, variable := range env {
+		name, _, _ := strings.Cut(variable, "=")
+		for _, allowed := range allowlist {
+			if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+				if strings.HasPrefix(name, prefix) {
+					filtered = append(filtered, variable)
+					break
+				}
+			} else if name == allowed {
+				filtered = append(filtered, variable)
+				break
+			}
+		}
+	}
+	return filtered
+}
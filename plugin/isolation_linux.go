@@ -0,0 +1,132 @@
+//go:build linux
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// cpuPeriod is the period of the CPU quota of the cgroups, in microseconds.
+const cpuPeriod = 100000
+
+// applyPlatform sets the user and the group of the command, and starts it in the
+// cgroup of the plugin, so that it is limited from the start.
+func (i *isolation) applyPlatform(command *exec.Cmd) error {
+	if i.uid >= 0 || i.gid >= 0 {
+		credential := &syscall.Credential{Uid: uint32(os.Getuid()), Gid: uint32(os.Getgid())}
+		if i.uid >= 0 {
+			credential.Uid = uint32(i.uid)
+		}
+		if i.gid >= 0 {
+			credential.Gid = uint32(i.gid)
+		}
+		// The supplementary groups of GatewayD are dropped.
+		credential.Groups = []uint32{}
+		sysProcAttr(command).Credential = credential
+	}
+
+	if i.settings.Cgroup == "" {
+		return nil
+	}
+
+	dir, err := i.createCgroup()
+	if err != nil {
+		return err
+	}
+	cgroup, err := os.Open(dir)
+	if err != nil {
+		return fmt.Errorf("failed to open the cgroup of the plugin: %w", err)
+	}
+	i.cgroup = cgroup
+	i.cgroupDir = dir
+	sysProcAttr(command).UseCgroupFD = true
+	sysProcAttr(command).CgroupFD = int(cgroup.Fd())
+	return nil
+}
+
+// sysProcAttr returns the system attributes of the command.
+func sysProcAttr(command *exec.Cmd) *syscall.SysProcAttr {
+	if command.SysProcAttr == nil {
+		command.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	return command.SysProcAttr
+}
+
+// createCgroup creates the cgroup of the plugin, or reuses it if the plugin is reloaded,
+// and sets its limits.
+func (i *isolation) createCgroup() (string, error) {
+	// The controllers may already be enabled, or only be enabled by the administrator.
+	_ = os.WriteFile(
+		filepath.Join(i.settings.Cgroup, "cgroup.subtree_control"), []byte("+memory +cpu"), 0)
+
+	dir := filepath.Join(i.settings.Cgroup, i.name)
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gosec
+		return "", fmt.Errorf("failed to create the cgroup of the plugin: %w", err)
+	}
+
+	memoryMax := "max"
+	if i.memoryLimit > 0 {
+		memoryMax = strconv.FormatInt(i.memoryLimit, 10)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "memory.max"), []byte(memoryMax), 0); err != nil {
+		return "", fmt.Errorf("failed to set the memory limit of the plugin: %w", err)
+	}
+
+	cpuMax := "max " + strconv.Itoa(cpuPeriod)
+	if i.settings.CPULimit > 0 {
+		cpuMax = fmt.Sprintf("%d %d", int64(i.settings.CPULimit*cpuPeriod), cpuPeriod)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "cpu.max"), []byte(cpuMax), 0); err != nil {
+		return "", fmt.Errorf("failed to set the CPU limit of the plugin: %w", err)
+	}
+
+	return dir, nil
+}
+
+// limit sets the rlimits of the started plugin. The memory is only limited by an rlimit
+// on the data segment if the plugin has no cgroup.
+func (i *isolation) limit(pid int) error {
+	i.release()
+
+	var errs []error
+	if i.settings.MaxOpenFiles > 0 {
+		limit := &unix.Rlimit{Cur: i.settings.MaxOpenFiles, Max: i.settings.MaxOpenFiles}
+		if err := unix.Prlimit(pid, unix.RLIMIT_NOFILE, limit, nil); err != nil {
+			errs = append(errs, fmt.Errorf("failed to limit the open files of the plugin: %w", err))
+		}
+	}
+	if i.memoryLimit > 0 && i.settings.Cgroup == "" {
+		limit := &unix.Rlimit{Cur: uint64(i.memoryLimit), Max: uint64(i.memoryLimit)}
+		if err := unix.Prlimit(pid, unix.RLIMIT_DATA, limit, nil); err != nil {
+			errs = append(errs, fmt.Errorf("failed to limit the memory of the plugin: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// release closes the cgroup of the plugin once it is started, or failed to start.
+func (i *isolation) release() {
+	if i.cgroup != nil {
+		i.cgroup.Close()
+		i.cgroup = nil
+	}
+}
+
+// discard releases and removes the cgroup of the plugin that failed to start. It is called
+// once the plugin is killed, so the cgroup is empty, unless another plugin process with the
+// same name still runs in it.
+func (i *isolation) discard() {
+	i.release()
+	if i.cgroupDir != "" {
+		_ = os.Remove(i.cgroupDir)
+		i.cgroupDir = ""
+	}
+}
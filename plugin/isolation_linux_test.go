@@ -0,0 +1,54 @@
+//go:build linux
+
+package plugin
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/gatewayd-io/gatewayd/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test_isolation tests that the plugin is started in its working directory, with the
+// allowed environment variables and with its rlimits.
+func Test_isolation(t *testing.T) {
+	sleep, err := exec.LookPath("sleep")
+	require.NoError(t, err)
+	t.Setenv("GATEWAYD_TEST_ALLOWED", "yes")
+	t.Setenv("GATEWAYD_TEST_SECRET", "no")
+
+	iso, err := newIsolation("test", config.PluginIsolation{
+		MemoryLimit:  "1GiB",
+		MaxOpenFiles: 64,
+		WorkingDir:   t.TempDir(),
+		EnvAllowlist: []string{"GATEWAYD_TEST_ALLOWED*"},
+	})
+	require.NoError(t, err)
+	assert.True(t, iso.skipHostEnv())
+
+	command := NewCommand(sleep, []string{"5"}, []string{"PLUGIN=test"})
+	require.NoError(t, iso.apply(command))
+	assert.True(t, filepath.IsAbs(command.Path))
+	assert.Equal(t, []string{"GATEWAYD_TEST_ALLOWED=yes", "PLUGIN=test"}, command.Env)
+
+	require.NoError(t, command.Start())
+	defer func() {
+		_ = command.Process.Kill()
+		_ = command.Wait()
+	}()
+	require.NoError(t, iso.limit(command.Process.Pid))
+
+	limits, err := os.ReadFile("/proc/" + strconv.Itoa(command.Process.Pid) + "/limits")
+	require.NoError(t, err)
+	assert.Regexp(t, `Max open files\s+64\s+64`, string(limits))
+	assert.Regexp(t, `Max data size\s+1073741824\s+1073741824`, string(limits))
+
+	dir, err := os.Readlink("/proc/" + strconv.Itoa(command.Process.Pid) + "/cwd")
+	require.NoError(t, err)
+	assert.Equal(t, command.Dir, dir)
+}
@@ -0,0 +1,30 @@
+//go:build !linux
+
+package plugin
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// applyPlatform returns an error if the isolation settings need Linux.
+func (i *isolation) applyPlatform(*exec.Cmd) error {
+	if i.uid >= 0 || i.gid >= 0 || i.memoryLimit > 0 || i.settings.MaxOpenFiles > 0 ||
+		i.settings.Cgroup != "" {
+		return fmt.Errorf( //nolint:goerr113
+			"the user, the group and the limits of the plugins aren't supported on %s", runtime.GOOS)
+	}
+	return nil
+}
+
+// limit does nothing, since the limits aren't supported.
+func (i *isolation) limit(int) error {
+	return nil
+}
+
+// release does nothing, since the plugins have no cgroups.
+func (i *isolation) release() {}
+
+// discard does nothing, since the plugins have no cgroups.
+func (i *isolation) discard() {}
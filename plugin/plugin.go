@@ -19,8 +19,17 @@ type IPlugin interface {
 
 var _ IPlugin = (*Plugin)(nil)
 
-// Start starts the plugin.
+// IsBuiltin returns true if the plugin is compiled into gatewayd and runs in-process.
+func (p *Plugin) IsBuiltin() bool {
+	return p.Client == nil && p.GatewayDPluginServiceServer != nil
+}
+
+// Start starts the plugin. The built-in plugins don't have to be started.
 func (p *Plugin) Start() (net.Addr, error) {
+	if p.IsBuiltin() {
+		return nil, nil
+	}
+
 	var addr net.Addr
 	var err error
 	if addr, err = p.Client.Start(); err != nil {
@@ -29,13 +38,22 @@ func (p *Plugin) Start() (net.Addr, error) {
 	return addr, nil
 }
 
-// Stop kills the plugin.
+// Stop kills the plugin. The built-in plugins stop with gatewayd.
 func (p *Plugin) Stop() {
-	p.Client.Kill()
+	if p.Client != nil {
+		p.Client.Kill()
+	}
 }
 
 // Dispense returns the plugin client.
 func (p *Plugin) Dispense() (v1.GatewayDPluginServiceClient, *gerr.GatewayDError) {
+	if p.IsBuiltin() {
+		return &builtinClient{server: p.GatewayDPluginServiceServer}, nil
+	}
+	if p.Client == nil {
+		return nil, gerr.ErrPluginNotReady
+	}
+
 	rpcClient, err := p.Client.Client()
 	if err != nil {
 		return nil, gerr.ErrFailedToGetRPCClient.Wrap(err)
@@ -53,8 +71,15 @@ func (p *Plugin) Dispense() (v1.GatewayDPluginServiceClient, *gerr.GatewayDError
 	return nil, gerr.ErrPluginNotReady
 }
 
-// Ping pings the plugin.
+// Ping pings the plugin. The built-in plugins are alive while gatewayd is.
 func (p *Plugin) Ping() *gerr.GatewayDError {
+	if p.IsBuiltin() {
+		return nil
+	}
+	if p.Client == nil {
+		return gerr.ErrPluginNotReady
+	}
+
 	rpcClient, err := p.Client.Client()
 	if err != nil {
 		return gerr.ErrFailedToGetRPCClient.Wrap(err)
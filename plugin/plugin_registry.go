@@ -4,16 +4,22 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
 	"sort"
+	"strings"
+	"sync"
 	"time"
 
-	"github.com/Masterminds/semver/v3"
 	sdkAct "github.com/gatewayd-io/gatewayd-plugin-sdk/act"
 	sdkPlugin "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin"
 	v1 "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin/v1"
 	"github.com/gatewayd-io/gatewayd/act"
 	"github.com/gatewayd-io/gatewayd/config"
 	gerr "github.com/gatewayd-io/gatewayd/errors"
+	"github.com/gatewayd-io/gatewayd/events"
 	"github.com/gatewayd-io/gatewayd/logging"
 	"github.com/gatewayd-io/gatewayd/metrics"
 	"github.com/gatewayd-io/gatewayd/pool"
@@ -47,8 +53,20 @@ type IRegistry interface {
 	ForEach(f func(sdkPlugin.Identifier, *Plugin))
 	Remove(pluginID sdkPlugin.Identifier)
 	Shutdown()
-	LoadPlugins(ctx context.Context, plugins []config.Plugin, startTimeout time.Duration)
-	RegisterHooks(ctx context.Context, pluginID sdkPlugin.Identifier)
+	LoadPlugins(
+		ctx context.Context, plugins []config.Plugin, startTimeout time.Duration,
+	) []UnsatisfiedPlugin
+	LoadExpressionHooks(hooks []config.ExpressionHook) *gerr.GatewayDError
+	LoadPlugin(
+		ctx context.Context,
+		pluginConfig config.Plugin,
+		priority sdkPlugin.Priority,
+		startTimeout time.Duration,
+	) *gerr.GatewayDError
+	UnloadPlugin(pluginID sdkPlugin.Identifier)
+	RegisterHooks(ctx context.Context, pluginID sdkPlugin.Identifier, hookNames ...v1.HookName)
+	UnregisterHooks(pluginID sdkPlugin.Identifier, hookNames ...v1.HookName)
+	RegisteredHooks(pluginID sdkPlugin.Identifier) []v1.HookName
 	Apply(hookName string, result *v1.Struct) ([]*sdkAct.Output, bool)
 
 	// Hook management
@@ -59,12 +77,39 @@ type Registry struct {
 	plugins     pool.IPool
 	ActRegistry *act.Registry
 	hooks       map[v1.HookName]map[sdkPlugin.Priority]sdkPlugin.Method
-	ctx         context.Context //nolint:containedctx
-	DevMode     bool
+	// hooksMu guards the hooks and the in-flight hook calls, since the hooks
+	// can be changed at runtime while the traffic is passed to them.
+	hooksMu *sync.RWMutex
+	// inflight tracks the running hook calls of each priority, so that a plugin
+	// is only stopped once the calls to its removed hooks have returned.
+	inflight map[sdkPlugin.Priority]*sync.WaitGroup
+	// settings are the timeouts, the circuit breakers and the hook priorities of the
+	// plugins by every priority that the plugins use.
+	settings map[sdkPlugin.Priority]*pluginSettings
+	ctx      context.Context //nolint:containedctx
+	DevMode  bool
 
 	Logger        zerolog.Logger
 	Compatibility config.CompatibilityPolicy
 	StartTimeout  time.Duration
+	// EventBus receives the plugin lifecycle events.
+	EventBus *events.Bus
+	// BuiltinPlugins are compiled into gatewayd and loaded before the plugins in the config.
+	BuiltinPlugins []BuiltinPlugin
+	// SignaturePolicy decides whether the plugins must be signed by one of the TrustedKeys.
+	SignaturePolicy config.SignaturePolicy
+	TrustedKeys     []TrustedKey
+
+	// AsyncHooks are run in the background by AsyncWorkers workers, which take the calls
+	// from a queue that holds AsyncQueueSize calls. PluginTimeout limits these calls.
+	AsyncHooks     []v1.HookName
+	AsyncWorkers   int
+	AsyncQueueSize int
+	PluginTimeout  time.Duration
+	asyncQueue     chan asyncCall
+	asyncMu        *sync.RWMutex
+	asyncClosed    bool
+	asyncWorkers   *sync.WaitGroup
 }
 
 var _ IRegistry = (*Registry)(nil)
@@ -77,15 +122,34 @@ func NewRegistry(
 	regCtx, span := otel.Tracer(config.TracerName).Start(ctx, "Create new registry")
 	defer span.End()
 
-	return &Registry{
-		plugins:       pool.NewPool(regCtx, config.EmptyPoolCapacity),
-		hooks:         map[v1.HookName]map[sdkPlugin.Priority]sdkPlugin.Method{},
-		ActRegistry:   registry.ActRegistry,
-		ctx:           regCtx,
-		DevMode:       registry.DevMode,
-		Logger:        registry.Logger,
-		Compatibility: registry.Compatibility,
+	reg := &Registry{
+		plugins:         pool.NewPool(regCtx, config.EmptyPoolCapacity),
+		hooks:           map[v1.HookName]map[sdkPlugin.Priority]sdkPlugin.Method{},
+		hooksMu:         &sync.RWMutex{},
+		inflight:        map[sdkPlugin.Priority]*sync.WaitGroup{},
+		settings:        map[sdkPlugin.Priority]*pluginSettings{},
+		ActRegistry:     registry.ActRegistry,
+		ctx:             regCtx,
+		DevMode:         registry.DevMode,
+		Logger:          registry.Logger,
+		Compatibility:   registry.Compatibility,
+		EventBus:        registry.EventBus,
+		BuiltinPlugins:  registry.BuiltinPlugins,
+		SignaturePolicy: registry.SignaturePolicy,
+		TrustedKeys:     registry.TrustedKeys,
+		AsyncHooks:      registry.AsyncHooks,
+		AsyncWorkers:    registry.AsyncWorkers,
+		AsyncQueueSize:  registry.AsyncQueueSize,
+		PluginTimeout:   registry.PluginTimeout,
+		asyncMu:         &sync.RWMutex{},
+		asyncWorkers:    &sync.WaitGroup{},
+	}
+
+	if len(reg.AsyncHooks) > 0 {
+		reg.startAsyncWorkers()
 	}
+
+	return reg
 }
 
 // Add adds a plugin to the registry.
@@ -143,30 +207,18 @@ func (reg *Registry) Exists(name, version, remoteURL string) bool {
 
 	for _, plugin := range reg.List() {
 		if plugin.Name == name && plugin.RemoteURL == remoteURL {
-			// Parse the supplied version and the version in the registry.
-			suppliedVer, err := semver.NewVersion(version)
+			// The supplied version is a semver range, or the minimum version.
+			satisfied, err := satisfiesVersion(version, plugin.Version)
 			if err != nil {
-				reg.Logger.Error().Err(err).Msg(
-					"Failed to parse supplied plugin version")
+				reg.Logger.Error().Err(err).Msg("Failed to check plugin version")
 				return false
 			}
-
-			registryVer, err := semver.NewVersion(plugin.Version)
-			if err != nil {
-				reg.Logger.Error().Err(err).Msg(
-					"Failed to parse plugin version in registry")
-				return false
-			}
-
-			// Check if the version of the plugin is less than or equal to
-			// the version in the registry.
-			// TODO: Should we check the major version only, or as well?
-			if suppliedVer.LessThan(registryVer) || suppliedVer.Equal(registryVer) {
+			if satisfied {
 				return true
 			}
 
 			reg.Logger.Debug().Str("name", name).Str("version", version).Msg(
-				"Supplied plugin version is greater than the version in registry")
+				"The plugin version in registry is not in the supplied version range")
 			return false
 		}
 	}
@@ -190,22 +242,55 @@ func (reg *Registry) ForEach(function func(sdkPlugin.Identifier, *Plugin)) {
 }
 
 // Remove removes plugin hooks and then removes the plugin from the registry.
+// It waits for the running calls to the hooks of the plugin to return.
 func (reg *Registry) Remove(pluginID sdkPlugin.Identifier) {
 	_, span := otel.Tracer(config.TracerName).Start(reg.ctx, "Remove")
 	defer span.End()
 
-	plugin := reg.Get(pluginID)
-	for _, hooks := range reg.hooks {
-		delete(hooks, plugin.Priority)
+	reg.UnregisterHooks(pluginID)
+	if plugin := reg.Get(pluginID); plugin != nil {
+		reg.hooksMu.Lock()
+		if settings, ok := reg.settings[plugin.Priority]; ok {
+			metrics.PluginCircuitBreakerState.DeleteLabelValues(settings.name)
+			for _, priority := range reg.priorities(plugin) {
+				delete(reg.settings, priority)
+			}
+		}
+		reg.hooksMu.Unlock()
 	}
 	reg.plugins.Remove(pluginID)
 }
 
+// UnloadPlugin removes the plugin from the registry and stops it, once the
+// running calls to its hooks have returned.
+func (reg *Registry) UnloadPlugin(pluginID sdkPlugin.Identifier) {
+	_, span := otel.Tracer(config.TracerName).Start(reg.ctx, "UnloadPlugin")
+	defer span.End()
+
+	plugin := reg.Get(pluginID)
+	if plugin == nil {
+		return
+	}
+
+	reg.Remove(pluginID)
+	if plugin.Client != nil {
+		plugin.Stop()
+	}
+	reg.Logger.Info().Str("name", pluginID.Name).Msg("Plugin is unloaded")
+	reg.EventBus.Publish(events.PluginUnloaded, "plugin", map[string]interface{}{
+		"name":    pluginID.Name,
+		"version": pluginID.Version,
+	})
+}
+
 // Shutdown shuts down all plugins in the registry.
 func (reg *Registry) Shutdown() {
 	_, span := otel.Tracer(config.TracerName).Start(reg.ctx, "Shutdown")
 	defer span.End()
 
+	// Run the queued async hooks before the plugins are stopped.
+	reg.stopAsyncWorkers()
+
 	reg.plugins.ForEach(func(key, value interface{}) bool {
 		if id, ok := key.(sdkPlugin.Identifier); ok {
 			if plugin, ok := value.(*Plugin); ok {
@@ -218,12 +303,32 @@ func (reg *Registry) Shutdown() {
 	goplugin.CleanupClients()
 }
 
-// Hooks returns the hooks map.
+// Hooks returns a copy of the hooks map.
 func (reg *Registry) Hooks() map[v1.HookName]map[sdkPlugin.Priority]sdkPlugin.Method {
 	_, span := otel.Tracer(config.TracerName).Start(reg.ctx, "Hooks")
 	defer span.End()
 
-	return reg.hooks
+	reg.hooksMu.RLock()
+	defer reg.hooksMu.RUnlock()
+
+	hooks := make(map[v1.HookName]map[sdkPlugin.Priority]sdkPlugin.Method, len(reg.hooks))
+	for hookName, methods := range reg.hooks {
+		hooks[hookName] = maps.Clone(methods)
+	}
+	return hooks
+}
+
+// HasHooks returns true if any hook of the given types is registered.
+func (reg *Registry) HasHooks(hookNames ...v1.HookName) bool {
+	reg.hooksMu.RLock()
+	defer reg.hooksMu.RUnlock()
+
+	for _, hookName := range hookNames {
+		if len(reg.hooks[hookName]) > 0 {
+			return true
+		}
+	}
+	return false
 }
 
 // AddHook adds a hook with a priority to the hooks map.
@@ -231,6 +336,13 @@ func (reg *Registry) AddHook(hookName v1.HookName, priority sdkPlugin.Priority,
 	_, span := otel.Tracer(config.TracerName).Start(reg.ctx, "AddHook")
 	defer span.End()
 
+	reg.hooksMu.Lock()
+	defer reg.hooksMu.Unlock()
+
+	if _, ok := reg.inflight[priority]; !ok {
+		reg.inflight[priority] = &sync.WaitGroup{}
+	}
+
 	if len(reg.hooks[hookName]) == 0 {
 		reg.hooks[hookName] = map[sdkPlugin.Priority]sdkPlugin.Method{priority: hookMethod}
 	} else {
@@ -252,6 +364,7 @@ func (reg *Registry) AddHook(hookName v1.HookName, priority sdkPlugin.Priority,
 // first hook as the argument. The result of the first hook is passed to the second
 // hook, and so on. The result of the last hook is eventually returned.
 // The opts are passed to the hooks as well to allow them to use the grpc.CallOption.
+// If a plugin fails closed, a terminal result and ErrPluginFailedClosed are returned.
 func (reg *Registry) Run(
 	ctx context.Context,
 	args map[string]any,
@@ -285,47 +398,35 @@ func (reg *Registry) Run(
 		return nil, gerr.ErrCastFailed.Wrap(err)
 	}
 
-	// Sort hooks by priority.
-	priorities := make([]sdkPlugin.Priority, 0, len(reg.hooks[hookName]))
-	for priority := range reg.hooks[hookName] {
-		priorities = append(priorities, priority)
+	// The results of the async hooks are ignored, so the args are returned unchanged.
+	if reg.IsAsync(hookName) {
+		reg.enqueueAsync(hookName, params, opts)
+		return args, nil
 	}
-	sort.SliceStable(priorities, func(i, j int) bool {
-		return priorities[i] < priorities[j]
-	})
+
+	methods, priorities, release := reg.acquireHooks(hookName)
+	defer release()
 
 	// Run hooks, passing the result of the previous hook to the next one.
 	returnVal := &v1.Struct{}
+	input := params
 	var outputs []*sdkAct.Output
 	// The signature of parameters and args MUST be the same for this to work.
-	for idx, priority := range priorities {
-		var result *v1.Struct
-		var err error
-		if idx == 0 {
-			result, err = reg.hooks[hookName][priority](inheritedCtx, params, opts...)
-		} else {
-			result, err = reg.hooks[hookName][priority](inheritedCtx, returnVal, opts...)
+	for _, priority := range priorities {
+		result, failClosed := reg.callHook(
+			inheritedCtx, hookName, priority, methods[priority], input, opts...)
+		if failClosed {
+			// The plugin failed closed, so the traffic is terminated. The callers
+			// close the connection when they receive the error.
+			reg.Logger.Debug().Msg("Plugin failed closed")
+			span.AddEvent("Plugin failed closed")
+			return map[string]any{
+				sdkAct.Outputs:  outputs,
+				sdkAct.Terminal: true,
+			}, gerr.ErrPluginFailedClosed
 		}
-
-		if err != nil {
-			reg.Logger.Error().Err(err).Fields(
-				map[string]any{
-					"hookName": hookName.String(),
-					"priority": priority,
-				},
-			).Msg("Hook returned an error")
-			span.RecordError(err)
-		}
-
 		if result == nil {
-			// Remove the hook from the registry, log the error and execute the next hook.
-			reg.Logger.Error().Fields(
-				map[string]any{
-					"hookName": hookName.String(),
-					"priority": priority,
-				},
-			).Msg("Hook returned nil result, so it won't work properly")
-			delete(reg.hooks[hookName], priority)
+			// The plugin failed open, so the next hook is executed.
 			continue
 		}
 
@@ -344,6 +445,7 @@ func (reg *Registry) Run(
 		}
 
 		returnVal = result
+		input = result
 	}
 
 	returnMap := returnVal.AsMap()
@@ -351,6 +453,37 @@ func (reg *Registry) Run(
 	return returnMap, nil
 }
 
+// acquireHooks returns the hooks that are registered now, sorted by priority, so that
+// the hooks can be changed while they run, and a function that releases them. A plugin
+// whose hooks are removed is stopped once the running calls to them are released.
+func (reg *Registry) acquireHooks(
+	hookName v1.HookName,
+) (map[sdkPlugin.Priority]sdkPlugin.Method, []sdkPlugin.Priority, func()) {
+	reg.hooksMu.RLock()
+	methods := maps.Clone(reg.hooks[hookName])
+	inflight := make([]*sync.WaitGroup, 0, len(methods))
+	for priority := range methods {
+		reg.inflight[priority].Add(1)
+		inflight = append(inflight, reg.inflight[priority])
+	}
+	reg.hooksMu.RUnlock()
+
+	// Sort hooks by priority.
+	priorities := make([]sdkPlugin.Priority, 0, len(methods))
+	for priority := range methods {
+		priorities = append(priorities, priority)
+	}
+	sort.SliceStable(priorities, func(i, j int) bool {
+		return priorities[i] < priorities[j]
+	})
+
+	return methods, priorities, func() {
+		for _, wait := range inflight {
+			wait.Done()
+		}
+	}
+}
+
 // Apply applies policies to the result.
 func (reg *Registry) Apply(hookName string, result *v1.Struct) ([]*sdkAct.Output, bool) {
 	_, span := otel.Tracer(config.TracerName).Start(reg.ctx, "Apply")
@@ -382,260 +515,557 @@ func (reg *Registry) Apply(hookName string, result *v1.Struct) ([]*sdkAct.Output
 	return outputs, terminal
 }
 
-// LoadPlugins loads plugins from the config file.
+// LoadPlugins loads the enabled plugins in the config file. The priority of each plugin
+// is set in the config or determined by its position in the list. The plugins are started
+// first to read their requirements from their metadata, and then they are added in the
+// order of their requirements, so that the plugins are added after the plugins they require.
+// The plugins whose requirements aren't met are reported and stopped, unless the registry
+// is in loose compatibility mode.
 func (reg *Registry) LoadPlugins(
 	ctx context.Context, plugins []config.Plugin, startTimeout time.Duration,
-) {
-	// TODO: Append built-in plugins to the list of plugins
-	// Built-in plugins are plugins that are compiled and shipped with the gatewayd binary.
+) []UnsatisfiedPlugin {
 	ctx, span := otel.Tracer("").Start(ctx, "Load plugins")
 	defer span.End()
 
-	// Add each plugin to the registry.
-	for priority, pCfg := range plugins {
-		pluginCtx, span := otel.Tracer("").Start(ctx, "Load plugin")
-		span.SetAttributes(attribute.Int("priority", priority))
-		span.SetAttributes(attribute.String("name", pCfg.Name))
-		span.SetAttributes(attribute.String("url", pCfg.URL))
-		span.SetAttributes(attribute.Bool("enabled", pCfg.Enabled))
-		span.SetAttributes(attribute.String("checksum", pCfg.Checksum))
-		span.SetAttributes(attribute.String("local_path", pCfg.LocalPath))
-		span.SetAttributes(attribute.StringSlice("args", pCfg.Args))
-		span.SetAttributes(attribute.StringSlice("env", pCfg.Env))
-		defer span.End()
-
-		reg.Logger.Debug().Str("name", pCfg.Name).Msg("Loading plugin")
-		plugin := &Plugin{
-			ID: sdkPlugin.Identifier{
-				Name:     pCfg.Name,
-				Checksum: pCfg.Checksum,
-			},
-			Enabled:   pCfg.Enabled,
-			LocalPath: pCfg.LocalPath,
-			Args:      pCfg.Args,
-			Env:       pCfg.Env,
-		}
+	// The reasons why the plugins can't be required, by name.
+	unavailable := make(map[string]string, len(plugins))
+	started := make([]*Plugin, 0, len(reg.BuiltinPlugins)+len(plugins))
+	settings := make(map[string]*pluginSettings, len(reg.BuiltinPlugins)+len(plugins))
 
-		span.AddEvent("Created plugin object")
+	// The built-in plugins are compiled into gatewayd, so they are always loaded.
+	for _, builtin := range reg.BuiltinPlugins {
+		plugin, pluginSettings, err := reg.startBuiltinPlugin(ctx, builtin)
+		if err != nil {
+			continue
+		}
+		started = append(started, plugin)
+		settings[plugin.ID.Name] = pluginSettings
+	}
 
-		// Is the plugin enabled?
-		plugin.Enabled = pCfg.Enabled
-		if !plugin.Enabled {
-			reg.Logger.Debug().Str("name", plugin.ID.Name).Msg("Plugin is disabled")
+	for index, pCfg := range plugins {
+		if !pCfg.Enabled {
+			reg.Logger.Debug().Str("name", pCfg.Name).Msg("Plugin is disabled")
+			unavailable[pCfg.Name] = "is disabled"
 			continue
 		}
 
-		// File path of the plugin on disk.
-		if plugin.LocalPath == "" {
-			reg.Logger.Debug().Str("name", plugin.ID.Name).Msg(
-				"Local file of the plugin doesn't exist or is not set")
+		// The errors are logged by startPlugin, and the plugin is skipped.
+		plugin, pluginSettings, err := reg.startPlugin(
+			ctx, pCfg, PluginPriority(pCfg, index), startTimeout)
+		if err != nil {
+			unavailable[pCfg.Name] = "failed to start: " + err.Error()
 			continue
 		}
+		started = append(started, plugin)
+		settings[plugin.ID.Name] = pluginSettings
+	}
 
-		var secureConfig *goplugin.SecureConfig
-		if !reg.DevMode {
-			// Checksum of the plugin.
-			if plugin.ID.Checksum == "" {
-				reg.Logger.Debug().Str("name", plugin.ID.Name).Msg(
-					"Checksum of plugin doesn't exist or is not set")
-				continue
-			}
+	span.AddEvent("Started plugins")
 
-			// Verify the checksum.
-			// TODO: Load the plugin from a remote location if the checksum didn't match?
-			checksum, err := hex.DecodeString(plugin.ID.Checksum)
-			if err != nil {
-				reg.Logger.Debug().Str("name", plugin.ID.Name).Err(err).Msg(
-					"Failed to decode checksum")
-				continue
-			}
+	sorted, cycles := sortByRequirements(started)
+	var report []UnsatisfiedPlugin
+	for _, plugin := range sorted {
+		var reasons []string
+		if cycle, ok := cycles[plugin.ID.Name]; ok {
+			reasons = append(reasons, "is in a dependency cycle: "+strings.Join(cycle, " -> "))
+		}
+		reasons = append(reasons, reg.unmetRequirements(plugin, unavailable)...)
 
-			if len(checksum) != sha256.Size {
-				reg.Logger.Debug().Str("name", plugin.ID.Name).Msg("Invalid checksum length")
+		if len(reasons) > 0 {
+			fields := map[string]any{
+				"name":    plugin.ID.Name,
+				"reasons": reasons,
+			}
+			if reg.Compatibility != config.Loose {
+				reg.Logger.Error().Fields(fields).Msg(
+					"The plugin requirements are not met, so the plugin won't be loaded")
+				plugin.Stop()
+				unavailable[plugin.ID.Name] = "is not loaded, because its requirements are not met"
+				report = append(report, UnsatisfiedPlugin{Name: plugin.ID.Name, Reasons: reasons})
 				continue
 			}
+			reg.Logger.Warn().Fields(fields).Msg(
+				"The plugin requirements are not met, but the registry is in loose compatibility " +
+					"mode, so the plugin will be loaded anyway")
+		}
 
-			secureConfig = &goplugin.SecureConfig{
-				Checksum: checksum,
-				Hash:     sha256.New(),
-			}
+		if err := reg.addPlugin(ctx, plugin, settings[plugin.ID.Name]); err != nil {
+			unavailable[plugin.ID.Name] = "failed to load: " + err.Error()
+		}
+	}
 
-			span.AddEvent("Created secure config for validating plugin checksum")
-		} else {
-			span.AddEvent("Skipping plugin checksum verification (dev mode)")
+	span.AddEvent("Loaded plugins")
+
+	if len(report) > 0 {
+		names := make([]string, 0, len(report))
+		for _, unsatisfied := range report {
+			names = append(names, unsatisfied.Name)
 		}
+		reg.Logger.Error().Strs("plugins", names).Msg(
+			"Some plugins are not loaded, because their requirements are not met")
+	}
+
+	return report
+}
 
-		// Plugin priority is determined by the order in which the plugin is listed
-		// in the config file. Built-in plugins are loaded first, followed by user-defined
-		// plugins. Built-in plugins have a priority of 0 to 999, and user-defined plugins
-		// have a priority of 1000 or greater.
-		plugin.Priority = sdkPlugin.Priority(config.PluginPriorityStart + uint(priority))
+// LoadPlugin starts a plugin, loads its metadata, adds it to the registry and
+// registers its hooks with the given priority. The plugins it requires must
+// be loaded already, unless the registry is in loose compatibility mode.
+func (reg *Registry) LoadPlugin(
+	ctx context.Context,
+	pCfg config.Plugin,
+	priority sdkPlugin.Priority,
+	startTimeout time.Duration,
+) *gerr.GatewayDError {
+	pluginCtx, span := otel.Tracer("").Start(ctx, "Load plugin")
+	span.SetAttributes(attribute.String("name", pCfg.Name))
+	defer span.End()
 
-		logAdapter := logging.NewHcLogAdapter(&reg.Logger, pCfg.Name)
+	plugin, settings, err := reg.startPlugin(pluginCtx, pCfg, priority, startTimeout)
+	if err != nil {
+		return err
+	}
 
-		plugin.Client = goplugin.NewClient(
-			&goplugin.ClientConfig{
-				HandshakeConfig: v1.Handshake,
-				Plugins:         v1.GetPluginMap(plugin.ID.Name),
-				Cmd:             NewCommand(plugin.LocalPath, plugin.Args, plugin.Env),
-				AllowedProtocols: []goplugin.Protocol{
-					goplugin.ProtocolGRPC,
-				},
-				SecureConfig: secureConfig,
-				Logger:       logAdapter,
-				Managed:      true,
-				MinPort:      config.DefaultMinPort,
-				MaxPort:      config.DefaultMaxPort,
-				AutoMTLS:     true,
-				StartTimeout: startTimeout,
-			},
-		)
+	// Check if the plugin requirements are met.
+	if reasons := reg.unmetRequirements(plugin, nil); len(reasons) > 0 {
+		fields := map[string]any{
+			"name":    plugin.ID.Name,
+			"reasons": reasons,
+		}
+		if reg.Compatibility != config.Loose {
+			reg.Logger.Debug().Fields(fields).Msg(
+				"Registry is in strict compatibility mode, so the plugin won't be loaded")
+			plugin.Stop()
+			return gerr.ErrFailedToLoadPlugin.Wrap(
+				fmt.Errorf("the requirements are not met: %s", strings.Join(reasons, "; ")))
+		}
+		reg.Logger.Debug().Fields(fields).Msg(
+			"Registry is in loose compatibility mode, so the plugin will be loaded anyway")
+	}
 
-		span.AddEvent("Created plugin client")
+	span.AddEvent("Verified plugin requirements")
 
-		reg.Logger.Debug().Str("name", plugin.ID.Name).Msg("Plugin loaded")
-		if _, err := plugin.Start(); err != nil {
-			reg.Logger.Debug().Str("name", plugin.ID.Name).Err(err).Msg(
-				"Failed to start plugin")
+	return reg.addPlugin(pluginCtx, plugin, settings)
+}
+
+// checkConflicts returns an error if the plugin is already loaded, or if its priority or
+// the priority of one of its hooks is taken by another plugin.
+func (reg *Registry) checkConflicts(
+	name string, priority sdkPlugin.Priority, settings *pluginSettings,
+) *gerr.GatewayDError {
+	var loaded *gerr.GatewayDError
+	reg.ForEach(func(_ sdkPlugin.Identifier, other *Plugin) {
+		if other.ID.Name == name {
+			loaded = gerr.ErrPluginAlreadyLoaded
+		} else if other.Priority == priority {
+			loaded = gerr.ErrPluginAlreadyLoaded.Wrap(
+				fmt.Errorf("the priority is taken by %q", other.ID.Name))
+		}
+	})
+	if loaded != nil {
+		return loaded
+	}
+
+	reg.hooksMu.RLock()
+	defer reg.hooksMu.RUnlock()
+	for _, hookPriority := range settings.hookPriorities {
+		if other, ok := reg.settings[hookPriority]; ok {
+			return gerr.ErrPluginAlreadyLoaded.Wrap(
+				fmt.Errorf("the priority %d is taken by %q", hookPriority, other.name))
+		}
+	}
+	return nil
+}
+
+// startPlugin starts a plugin and loads its metadata, including its requirements,
+// without adding it to the registry.
+//
+//nolint:funlen
+func (reg *Registry) startPlugin(
+	ctx context.Context,
+	pCfg config.Plugin,
+	priority sdkPlugin.Priority,
+	startTimeout time.Duration,
+) (*Plugin, *pluginSettings, *gerr.GatewayDError) {
+	_, span := otel.Tracer("").Start(ctx, "Start plugin")
+	span.SetAttributes(attribute.Int("priority", int(priority)))
+	span.SetAttributes(attribute.String("name", pCfg.Name))
+	span.SetAttributes(attribute.String("url", pCfg.URL))
+	span.SetAttributes(attribute.Bool("enabled", pCfg.Enabled))
+	span.SetAttributes(attribute.String("checksum", pCfg.Checksum))
+	span.SetAttributes(attribute.String("local_path", pCfg.LocalPath))
+	span.SetAttributes(attribute.StringSlice("args", pCfg.Args))
+	span.SetAttributes(attribute.StringSlice("env", pCfg.Env))
+	defer span.End()
+
+	reg.Logger.Debug().Str("name", pCfg.Name).Msg("Loading plugin")
+	plugin := &Plugin{
+		ID: sdkPlugin.Identifier{
+			Name:     pCfg.Name,
+			Checksum: pCfg.Checksum,
+		},
+		Enabled:   pCfg.Enabled,
+		LocalPath: pCfg.LocalPath,
+		Args:      pCfg.Args,
+		Env:       pCfg.Env,
+	}
+
+	span.AddEvent("Created plugin object")
+
+	// Is the plugin enabled?
+	plugin.Enabled = pCfg.Enabled
+	if !plugin.Enabled {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Msg("Plugin is disabled")
+		return nil, nil, gerr.ErrFailedToLoadPlugin.Wrap(errors.New("plugin is disabled"))
+	}
+
+	// The timeouts and the failure handling of the hooks.
+	settings, settingsErr := newPluginSettings(pCfg)
+	if settingsErr != nil {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Err(settingsErr).Msg(
+			"Invalid hook settings")
+		return nil, nil, gerr.ErrFailedToLoadPlugin.Wrap(settingsErr)
+	}
+
+	iso, isoErr := newIsolation(plugin.ID.Name, pCfg.Isolation)
+	if isoErr != nil {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Err(isoErr).Msg(
+			"Invalid isolation settings")
+		return nil, nil, gerr.ErrFailedToLoadPlugin.Wrap(isoErr)
+	}
+
+	// The plugin is killed and its cgroup is removed if it fails to start.
+	started := false
+	defer func() {
+		if started {
+			return
+		}
+		if plugin.Client != nil {
 			plugin.Client.Kill()
-			continue
 		}
+		iso.discard()
+	}()
+
+	// Is the plugin or its priority already taken?
+	if err := reg.checkConflicts(plugin.ID.Name, priority, settings); err != nil {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Err(err).Msg(
+			"Plugin is already loaded")
+		return nil, nil, err
+	}
+
+	// File path of the plugin on disk.
+	if plugin.LocalPath == "" {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Msg(
+			"Local file of the plugin doesn't exist or is not set")
+		return nil, nil, gerr.ErrFailedToLoadPlugin.Wrap(errors.New("local path is not set"))
+	}
 
-		span.AddEvent("Started plugin")
+	var secureConfig *goplugin.SecureConfig
+	if !reg.DevMode {
+		// Checksum of the plugin.
+		if plugin.ID.Checksum == "" {
+			reg.Logger.Debug().Str("name", plugin.ID.Name).Msg(
+				"Checksum of plugin doesn't exist or is not set")
+			return nil, nil, gerr.ErrFailedToLoadPlugin.Wrap(errors.New("checksum is not set"))
+		}
 
-		// Load metadata from the plugin.
-		pluginV1, err := plugin.Dispense()
+		// Verify the checksum.
+		// TODO: Load the plugin from a remote location if the checksum didn't match?
+		checksum, err := hex.DecodeString(plugin.ID.Checksum)
 		if err != nil {
 			reg.Logger.Debug().Str("name", plugin.ID.Name).Err(err).Msg(
-				"Failed to dispense plugin")
-			plugin.Client.Kill()
-			continue
+				"Failed to decode checksum")
+			return nil, nil, gerr.ErrFailedToLoadPlugin.Wrap(err)
 		}
 
-		metadata, origErr := pluginV1.GetPluginConfig( //nolint:contextcheck
-			context.Background(), &v1.Struct{})
-		if origErr != nil || metadata == nil {
-			reg.Logger.Debug().Str("name", plugin.ID.Name).Err(origErr).Msg(
-				"Failed to get plugin metadata")
-			continue
+		if len(checksum) != sha256.Size {
+			reg.Logger.Debug().Str("name", plugin.ID.Name).Msg("Invalid checksum length")
+			return nil, nil, gerr.ErrFailedToLoadPlugin.Wrap(errors.New("invalid checksum length"))
 		}
 
-		span.AddEvent("Fetched plugin metadata")
+		secureConfig = &goplugin.SecureConfig{
+			Checksum: checksum,
+			Hash:     sha256.New(),
+		}
 
-		// Retrieve plugin requirements.
-		if requires, ok := metadata.GetFields()["requires"]; ok && requires != nil && requires.GetListValue() != nil {
-			if err := mapstructure.Decode(
-				requires.GetListValue().AsSlice(), &plugin.Requires); err != nil {
-				reg.Logger.Debug().Err(err).Msg("Failed to decode plugin requirements")
-			}
-		} else {
-			reg.Logger.Debug().Str("name", plugin.ID.Name).Msg(
-				"Plugin doesn't have any requirements")
-		}
-
-		// Too many requirements or not enough plugins loaded.
-		// Note: Plugin requirements won't cause the required plugins to be loaded.
-		if len(plugin.Requires) > reg.plugins.Size() {
-			reg.Logger.Debug().Msg(
-				"The plugin has too many requirements, " +
-					"and not enough of them exist in the registry, so it won't work properly")
-		}
-
-		// Check if the plugin requirements are met.
-		for _, req := range plugin.Requires {
-			if !reg.Exists(req.Name, req.Version, req.RemoteURL) {
-				reg.Logger.Debug().Fields(
-					map[string]any{
-						"name":        plugin.ID.Name,
-						"requirement": req.Name,
-					},
-				).Msg("The plugin requirement is not met, so it won't work properly")
-				if reg.Compatibility == config.Strict {
-					reg.Logger.Debug().Str("name", plugin.ID.Name).Msg(
-						"Registry is in strict compatibility mode, so the plugin won't be loaded")
-					plugin.Stop() // Stop the plugin.
-					continue
-				}
-				reg.Logger.Debug().Fields(
-					map[string]any{
-						"name":        plugin.ID.Name,
-						"requirement": req.Name,
-					},
-				).Msg("Registry is in loose compatibility mode, " +
-					"so the plugin will be loaded anyway")
-			}
+		span.AddEvent("Created secure config for validating plugin checksum")
+
+		// Verify the signature of the plugin, so that the binary and its checksum
+		// can't be replaced without the private key of a trusted signer.
+		if err := reg.verifySignature(pCfg); err != nil {
+			reg.Logger.Error().Str("name", plugin.ID.Name).Err(err).Msg(
+				"Failed to verify plugin signature")
+			return nil, nil, gerr.ErrFailedToLoadPlugin.Wrap(err)
 		}
 
-		span.AddEvent("Verified plugin requirements")
+		span.AddEvent("Verified plugin signature")
+	} else {
+		span.AddEvent("Skipping plugin checksum and signature verification (dev mode)")
+	}
 
-		plugin.ID.RemoteURL = metadata.GetFields()["id"].GetStructValue().GetFields()["remoteUrl"].GetStringValue()
-		plugin.ID.Version = metadata.GetFields()["id"].GetStructValue().GetFields()["version"].GetStringValue()
-		plugin.Description = metadata.GetFields()["description"].GetStringValue()
-		plugin.License = metadata.GetFields()["license"].GetStringValue()
-		plugin.ProjectURL = metadata.GetFields()["projectUrl"].GetStringValue()
-		// Retrieve authors.
-		if metadata.GetFields()["authors"] != nil && metadata.GetFields()["authors"].GetListValue() != nil {
-			if err := mapstructure.Decode(metadata.GetFields()["authors"].GetListValue().AsSlice(),
-				&plugin.Authors); err != nil {
-				reg.Logger.Debug().Err(err).Msg("Failed to decode plugin authors")
-			}
-		} else {
-			reg.Logger.Debug().Str("name", plugin.ID.Name).Msg(
-				"Plugin doesn't have any authors")
+	// Plugin priority is set in the config file or determined by the order in which
+	// the plugin is listed in the config file. Built-in plugins are loaded first, followed by user-defined
+	// plugins. Built-in plugins have a priority of 0 to 999, and user-defined plugins
+	// have a priority of 1000 or greater.
+	plugin.Priority = priority
+
+	logAdapter := logging.NewHcLogAdapter(&reg.Logger, pCfg.Name)
+
+	command := NewCommand(plugin.LocalPath, plugin.Args, plugin.Env)
+	if err := iso.apply(command); err != nil {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Err(err).Msg(
+			"Failed to isolate plugin")
+		return nil, nil, gerr.ErrFailedToStartPlugin.Wrap(err)
+	}
+
+	span.AddEvent("Isolated plugin command")
+
+	plugin.Client = goplugin.NewClient(
+		&goplugin.ClientConfig{
+			HandshakeConfig: v1.Handshake,
+			Plugins:         v1.GetPluginMap(plugin.ID.Name),
+			Cmd:             command,
+			// The environment of GatewayD is filtered by the isolation settings.
+			SkipHostEnv: iso.skipHostEnv(),
+			AllowedProtocols: []goplugin.Protocol{
+				goplugin.ProtocolGRPC,
+			},
+			SecureConfig: secureConfig,
+			Logger:       logAdapter,
+			Managed:      true,
+			MinPort:      config.DefaultMinPort,
+			MaxPort:      config.DefaultMaxPort,
+			AutoMTLS:     true,
+			StartTimeout: startTimeout,
+		},
+	)
+
+	span.AddEvent("Created plugin client")
+
+	reg.Logger.Debug().Str("name", plugin.ID.Name).Msg("Plugin loaded")
+	if _, err := plugin.Start(); err != nil {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Err(err).Msg(
+			"Failed to start plugin")
+		return nil, nil, gerr.ErrFailedToStartPlugin.Wrap(err)
+	}
+
+	span.AddEvent("Started plugin")
+
+	// The rlimits can only be set once the process of the plugin is started.
+	if err := iso.limit(command.Process.Pid); err != nil {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Err(err).Msg(
+			"Failed to limit plugin")
+		return nil, nil, gerr.ErrFailedToStartPlugin.Wrap(err)
+	}
+
+	if err := reg.loadMetadata(plugin); err != nil {
+		return nil, nil, err
+	}
+
+	span.AddEvent("Decoded plugin metadata")
+
+	reg.Logger.Trace().Msgf("Plugin metadata: %+v", plugin)
+
+	started = true
+	return plugin, settings, nil
+}
+
+// startBuiltinPlugin creates a plugin from the built-in plugin and loads its metadata.
+func (reg *Registry) startBuiltinPlugin(
+	ctx context.Context, builtin BuiltinPlugin,
+) (*Plugin, *pluginSettings, *gerr.GatewayDError) {
+	_, span := otel.Tracer("").Start(ctx, "Start built-in plugin")
+	span.SetAttributes(attribute.Int("priority", int(builtin.Priority)))
+	defer span.End()
+
+	if builtin.Impl == nil {
+		reg.Logger.Error().Uint("priority", uint(builtin.Priority)).Msg(
+			"Built-in plugin has no implementation, so it won't be loaded")
+		return nil, nil, gerr.ErrFailedToLoadPlugin.Wrap(
+			errors.New("built-in plugin has no implementation"))
+	}
+
+	plugin := &Plugin{
+		GatewayDPluginServiceServer: builtin.Impl,
+		Enabled:                     true,
+		Priority:                    builtin.Priority,
+	}
+	if err := reg.loadMetadata(plugin); err != nil {
+		reg.Logger.Error().Err(err).Uint("priority", uint(builtin.Priority)).Msg(
+			"Failed to load the metadata of the built-in plugin")
+		return nil, nil, err
+	}
+	span.SetAttributes(attribute.String("name", plugin.ID.Name))
+
+	if plugin.ID.Name == "" {
+		reg.Logger.Error().Uint("priority", uint(builtin.Priority)).Msg(
+			"Built-in plugin has no name, so it won't be loaded")
+		return nil, nil, gerr.ErrFailedToLoadPlugin.Wrap(
+			errors.New("built-in plugin has no name"))
+	}
+
+	if builtin.Priority >= config.PluginPriorityStart {
+		reg.Logger.Error().Str("name", plugin.ID.Name).Uint(
+			"priority", uint(builtin.Priority)).Msgf(
+			"The priorities of the built-in plugins must be below %d", config.PluginPriorityStart)
+		return nil, nil, gerr.ErrFailedToLoadPlugin.Wrap(
+			fmt.Errorf("the priority %d of the built-in plugin %q isn't below %d",
+				builtin.Priority, plugin.ID.Name, config.PluginPriorityStart))
+	}
+
+	// The hooks of the built-in plugins have the default timeouts and failure handling.
+	settings, settingsErr := newPluginSettings(config.Plugin{Name: plugin.ID.Name})
+	if settingsErr != nil {
+		return nil, nil, gerr.ErrFailedToLoadPlugin.Wrap(settingsErr)
+	}
+
+	if err := reg.checkConflicts(plugin.ID.Name, plugin.Priority, settings); err != nil {
+		reg.Logger.Error().Str("name", plugin.ID.Name).Err(err).Msg(
+			"Built-in plugin is already loaded")
+		return nil, nil, err
+	}
+
+	reg.Logger.Trace().Msgf("Built-in plugin metadata: %+v", plugin)
+
+	return plugin, settings, nil
+}
+
+// loadMetadata loads the metadata of the started plugin: its identifier, requirements,
+// hooks and config.
+func (reg *Registry) loadMetadata(plugin *Plugin) *gerr.GatewayDError {
+	pluginV1, err := plugin.Dispense()
+	if err != nil {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Err(err).Msg(
+			"Failed to dispense plugin")
+		return err
+	}
+
+	metadata, origErr := pluginV1.GetPluginConfig( //nolint:contextcheck
+		context.Background(), &v1.Struct{})
+	if origErr != nil || metadata == nil {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Err(origErr).Msg(
+			"Failed to get plugin metadata")
+		return gerr.ErrFailedToLoadPlugin.Wrap(
+			fmt.Errorf("failed to get plugin metadata: %w", origErr))
+	}
+
+	// Retrieve plugin requirements.
+	if requires, ok := metadata.GetFields()["requires"]; ok && requires != nil && requires.GetListValue() != nil {
+		if err := mapstructure.Decode(
+			requires.GetListValue().AsSlice(), &plugin.Requires); err != nil {
+			reg.Logger.Debug().Err(err).Msg("Failed to decode plugin requirements")
 		}
+	} else {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Msg(
+			"Plugin doesn't have any requirements")
+	}
 
-		// Retrieve hooks.
-		if metadata.GetFields()["hooks"] != nil && metadata.GetFields()["hooks"].GetListValue() != nil {
-			if err := mapstructure.Decode(metadata.GetFields()["hooks"].GetListValue().AsSlice(),
-				&plugin.Hooks); err != nil {
-				reg.Logger.Debug().Err(err).Msg("Failed to decode plugin hooks")
-			}
-		} else {
-			reg.Logger.Debug().Str("name", plugin.ID.Name).Msg(
-				"Plugin doesn't attach to any hooks")
-		}
-
-		// Retrieve plugin config.
-		plugin.Config = make(map[string]string)
-		if metadata.GetFields()["config"] != nil && metadata.GetFields()["config"].GetStructValue() != nil {
-			for key, value := range metadata.GetFields()["config"].GetStructValue().AsMap() {
-				if val, ok := value.(string); ok {
-					plugin.Config[key] = val
-				} else {
-					reg.Logger.Debug().Str("key", key).Msg(
-						"Failed to decode plugin config")
-				}
-			}
-		} else {
-			reg.Logger.Debug().Str("name", plugin.ID.Name).Msg(
-				"Plugin doesn't have any config")
+	// The built-in plugins are only named by their metadata.
+	if plugin.ID.Name == "" {
+		plugin.ID.Name = metadata.GetFields()["id"].GetStructValue().GetFields()["name"].GetStringValue()
+	}
+	plugin.ID.RemoteURL = metadata.GetFields()["id"].GetStructValue().GetFields()["remoteUrl"].GetStringValue()
+	plugin.ID.Version = metadata.GetFields()["id"].GetStructValue().GetFields()["version"].GetStringValue()
+	plugin.Description = metadata.GetFields()["description"].GetStringValue()
+	plugin.License = metadata.GetFields()["license"].GetStringValue()
+	plugin.ProjectURL = metadata.GetFields()["projectUrl"].GetStringValue()
+	// Retrieve authors.
+	if metadata.GetFields()["authors"] != nil && metadata.GetFields()["authors"].GetListValue() != nil {
+		if err := mapstructure.Decode(metadata.GetFields()["authors"].GetListValue().AsSlice(),
+			&plugin.Authors); err != nil {
+			reg.Logger.Debug().Err(err).Msg("Failed to decode plugin authors")
 		}
+	} else {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Msg(
+			"Plugin doesn't have any authors")
+	}
+
+	// Retrieve hooks.
+	if metadata.GetFields()["hooks"] != nil && metadata.GetFields()["hooks"].GetListValue() != nil {
+		if err := mapstructure.Decode(metadata.GetFields()["hooks"].GetListValue().AsSlice(),
+			&plugin.Hooks); err != nil {
+			reg.Logger.Debug().Err(err).Msg("Failed to decode plugin hooks")
+		}
+	} else {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Msg(
+			"Plugin doesn't attach to any hooks")
+	}
 
-		span.AddEvent("Decoded plugin metadata")
+	// Retrieve plugin config.
+	plugin.Config = make(map[string]string)
+	if metadata.GetFields()["config"] != nil && metadata.GetFields()["config"].GetStructValue() != nil {
+		for key, value := range metadata.GetFields()["config"].GetStructValue().AsMap() {
+			if val, ok := value.(string); ok {
+				plugin.Config[key] = val
+			} else {
+				reg.Logger.Debug().Str("key", key).Msg(
+					"Failed to decode plugin config")
+			}
+		}
+	} else {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Msg(
+			"Plugin doesn't have any config")
+	}
 
-		reg.Logger.Trace().Msgf("Plugin metadata: %+v", plugin)
+	return nil
+}
 
-		reg.Add(plugin)
-		reg.Logger.Debug().Str("name", plugin.ID.Name).Msg("Plugin metadata loaded")
+// addPlugin adds the started plugin to the registry and registers its hooks.
+func (reg *Registry) addPlugin(
+	ctx context.Context, plugin *Plugin, settings *pluginSettings,
+) *gerr.GatewayDError {
+	pluginCtx, span := otel.Tracer("").Start(ctx, "Add plugin")
+	defer span.End()
 
-		span.AddEvent("Plugin metadata loaded")
+	// The plugins of the same config are started before any of them is added.
+	if err := reg.checkConflicts(plugin.ID.Name, plugin.Priority, settings); err != nil {
+		reg.Logger.Debug().Str("name", plugin.ID.Name).Err(err).Msg(
+			"Plugin is already loaded")
+		plugin.Stop()
+		return err
+	}
 
-		reg.RegisterHooks(pluginCtx, plugin.ID)
-		reg.Logger.Debug().Str("name", plugin.ID.Name).Msg("Plugin hooks registered")
+	reg.Add(plugin)
+	reg.Logger.Debug().Str("name", plugin.ID.Name).Msg("Plugin metadata loaded")
 
-		span.AddEvent("Registered plugin hooks")
+	span.AddEvent("Plugin metadata loaded")
 
-		metrics.PluginsLoaded.Inc()
-		reg.Logger.Info().Str("name", plugin.ID.Name).Msg("Plugin is ready")
+	reg.hooksMu.Lock()
+	reg.settings[plugin.Priority] = settings
+	for _, hookPriority := range settings.hookPriorities {
+		reg.settings[hookPriority] = settings
 	}
+	reg.hooksMu.Unlock()
+
+	reg.RegisterHooks(pluginCtx, plugin.ID)
+	reg.Logger.Debug().Str("name", plugin.ID.Name).Msg("Plugin hooks registered")
+
+	span.AddEvent("Registered plugin hooks")
+
+	metrics.PluginsLoaded.Inc()
+	reg.Logger.Info().Str("name", plugin.ID.Name).Msg("Plugin is ready")
+	reg.EventBus.Publish(events.PluginLoaded, "plugin", map[string]interface{}{
+		"name":     plugin.ID.Name,
+		"version":  plugin.ID.Version,
+		"priority": int(plugin.Priority),
+	})
+
+	return nil
 }
 
-// RegisterHooks registers the hooks for the given plugin.
-func (reg *Registry) RegisterHooks(ctx context.Context, pluginID sdkPlugin.Identifier) {
+// RegisterHooks registers the hooks for the given plugin. If hook names are given,
+// only the hooks of the plugin with those names are registered.
+func (reg *Registry) RegisterHooks(
+	ctx context.Context, pluginID sdkPlugin.Identifier, hookNames ...v1.HookName,
+) {
 	_, span := otel.Tracer("gatewayd").Start(ctx, "Register plugin hooks")
 	defer span.End()
 
 	pluginImpl := reg.Get(pluginID)
+	if pluginImpl == nil {
+		reg.Logger.Debug().Str("name", pluginID.Name).Msg("Plugin is not in the registry")
+		return
+	}
 	reg.Logger.Debug().Str("name", pluginImpl.ID.Name).Msg(
 		"Registering hooks for plugin")
 	var pluginV1 v1.GatewayDPluginServiceClient
@@ -657,6 +1087,10 @@ func (reg *Registry) RegisterHooks(ctx context.Context, pluginID sdkPlugin.Ident
 		"Plugin hooks: %+v", pluginImpl.Hooks)
 
 	for _, hookName := range pluginImpl.Hooks {
+		if len(hookNames) > 0 && !slices.Contains(hookNames, hookName) {
+			continue
+		}
+
 		var hookMethod sdkPlugin.Method
 		switch hookName {
 		case v1.HookName_HOOK_NAME_UNSPECIFIED:
@@ -718,6 +1152,131 @@ func (reg *Registry) RegisterHooks(ctx context.Context, pluginID sdkPlugin.Ident
 			"name":     pluginImpl.ID.Name,
 		}).Msg("Registering hook")
 		metrics.PluginHooksRegistered.Inc()
-		reg.AddHook(hookName, pluginImpl.Priority, hookMethod)
+		reg.hooksMu.RLock()
+		priority := reg.hookPriority(pluginImpl, hookName)
+		reg.hooksMu.RUnlock()
+		reg.AddHook(hookName, priority, hookMethod)
+	}
+}
+
+// UnregisterHooks removes the hooks of the given plugin, so that the traffic is no longer
+// passed to them, and waits for the running calls to them to return. If hook names are
+// given, only the hooks with those names are removed.
+func (reg *Registry) UnregisterHooks(pluginID sdkPlugin.Identifier, hookNames ...v1.HookName) {
+	_, span := otel.Tracer(config.TracerName).Start(reg.ctx, "Unregister plugin hooks")
+	defer span.End()
+
+	plugin := reg.Get(pluginID)
+	if plugin == nil {
+		return
+	}
+
+	reg.hooksMu.Lock()
+	for hookName, hooks := range reg.hooks {
+		if len(hookNames) == 0 || slices.Contains(hookNames, hookName) {
+			delete(hooks, reg.hookPriority(plugin, hookName))
+		}
+	}
+	// The calls that start from now on are tracked separately.
+	inflight := make([]*sync.WaitGroup, 0, 1)
+	for _, priority := range reg.priorities(plugin) {
+		if wait, ok := reg.inflight[priority]; ok {
+			inflight = append(inflight, wait)
+		}
+		reg.inflight[priority] = &sync.WaitGroup{}
+	}
+	reg.hooksMu.Unlock()
+
+	for _, wait := range inflight {
+		wait.Wait()
+	}
+	reg.Logger.Debug().Str("name", pluginID.Name).Msg("Plugin hooks unregistered")
+}
+
+// RegisteredHooks returns the hooks of the given plugin that are registered.
+func (reg *Registry) RegisteredHooks(pluginID sdkPlugin.Identifier) []v1.HookName {
+	_, span := otel.Tracer(config.TracerName).Start(reg.ctx, "RegisteredHooks")
+	defer span.End()
+
+	plugin := reg.Get(pluginID)
+	if plugin == nil {
+		return nil
+	}
+
+	reg.hooksMu.RLock()
+	defer reg.hooksMu.RUnlock()
+
+	hookNames := make([]v1.HookName, 0, len(plugin.Hooks))
+	for _, hookName := range plugin.Hooks {
+		if _, ok := reg.hooks[hookName][reg.hookPriority(plugin, hookName)]; ok {
+			hookNames = append(hookNames, hookName)
+		}
+	}
+	return hookNames
+}
+
+// ChainedHook is a hook in the chain of the hooks of a type.
+type ChainedHook struct {
+	Plugin   string
+	Priority sdkPlugin.Priority
+}
+
+// HookChains returns the hooks of each type in the order they are run, that is sorted
+// by priority, with the names of their plugins.
+func (reg *Registry) HookChains() map[v1.HookName][]ChainedHook {
+	_, span := otel.Tracer(config.TracerName).Start(reg.ctx, "HookChains")
+	defer span.End()
+
+	owners := map[sdkPlugin.Priority]string{}
+	reg.ForEach(func(_ sdkPlugin.Identifier, plugin *Plugin) {
+		owners[plugin.Priority] = plugin.ID.Name
+	})
+
+	reg.hooksMu.RLock()
+	defer reg.hooksMu.RUnlock()
+
+	for priority, settings := range reg.settings {
+		owners[priority] = settings.name
+	}
+
+	chains := make(map[v1.HookName][]ChainedHook, len(reg.hooks))
+	for hookName, methods := range reg.hooks {
+		if len(methods) == 0 {
+			continue
+		}
+		chain := make([]ChainedHook, 0, len(methods))
+		for priority := range methods {
+			chain = append(chain, ChainedHook{Plugin: owners[priority], Priority: priority})
+		}
+		sort.Slice(chain, func(i, j int) bool {
+			return chain[i].Priority < chain[j].Priority
+		})
+		chains[hookName] = chain
+	}
+	return chains
+}
+
+// hookPriority returns the priority of the hook of the plugin, which is the priority
+// of the plugin, unless it is overridden for the hook. The caller must hold hooksMu.
+func (reg *Registry) hookPriority(plugin *Plugin, hookName v1.HookName) sdkPlugin.Priority {
+	if settings, ok := reg.settings[plugin.Priority]; ok {
+		if priority, ok := settings.hookPriorities[hookName]; ok {
+			return priority
+		}
+	}
+	return plugin.Priority
+}
+
+// priorities returns the priority of the plugin and the priorities of its hooks that
+// are overridden. The caller must hold hooksMu.
+func (reg *Registry) priorities(plugin *Plugin) []sdkPlugin.Priority {
+	priorities := []sdkPlugin.Priority{plugin.Priority}
+	if settings, ok := reg.settings[plugin.Priority]; ok {
+		for _, priority := range settings.hookPriorities {
+			if !slices.Contains(priorities, priority) {
+				priorities = append(priorities, priority)
+			}
+		}
 	}
+	return priorities
 }
@@ -2,16 +2,30 @@ package plugin
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	sdkAct "github.com/gatewayd-io/gatewayd-plugin-sdk/act"
 	sdkPlugin "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin"
 	v1 "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin/v1"
 	"github.com/gatewayd-io/gatewayd/act"
 	"github.com/gatewayd-io/gatewayd/config"
+	gerr "github.com/gatewayd-io/gatewayd/errors"
 	"github.com/gatewayd-io/gatewayd/logging"
+	"github.com/gatewayd-io/gatewayd/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 )
 
@@ -134,6 +148,330 @@ func Test_PluginRegistry_Run(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+// Test_PluginRegistry_UnregisterHooks tests that the hooks of a plugin can be removed
+// and registered again, and that the running calls to them return first.
+func Test_PluginRegistry_UnregisterHooks(t *testing.T) {
+	reg := NewPluginRegistry(t)
+	impl := &Plugin{
+		ID:       sdkPlugin.Identifier{Name: "test"},
+		Priority: 1000,
+		Hooks: []v1.HookName{
+			v1.HookName_HOOK_NAME_ON_NEW_LOGGER,
+			v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT,
+		},
+	}
+	reg.Add(impl)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	reg.AddHook(v1.HookName_HOOK_NAME_ON_NEW_LOGGER, impl.Priority, func(
+		_ context.Context,
+		args *v1.Struct,
+		_ ...grpc.CallOption,
+	) (*v1.Struct, error) {
+		close(started)
+		<-release
+		return args, nil
+	})
+	reg.AddHook(v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT, impl.Priority, func(
+		_ context.Context,
+		args *v1.Struct,
+		_ ...grpc.CallOption,
+	) (*v1.Struct, error) {
+		return args, nil
+	})
+	assert.ElementsMatch(t, impl.Hooks, reg.RegisteredHooks(impl.ID))
+
+	go func() {
+		_, err := reg.Run(
+			context.Background(), map[string]interface{}{}, v1.HookName_HOOK_NAME_ON_NEW_LOGGER)
+		assert.Nil(t, err)
+	}()
+	<-started
+
+	unregistered := make(chan struct{})
+	go func() {
+		reg.UnregisterHooks(impl.ID, v1.HookName_HOOK_NAME_ON_NEW_LOGGER)
+		close(unregistered)
+	}()
+
+	// The hook is removed at once, but UnregisterHooks waits for the running call.
+	assert.Eventually(t, func() bool {
+		return !reg.HasHooks(v1.HookName_HOOK_NAME_ON_NEW_LOGGER)
+	}, time.Second, time.Millisecond)
+	select {
+	case <-unregistered:
+		t.Fatal("UnregisterHooks returned before the running hook")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	<-unregistered
+
+	assert.Equal(t,
+		[]v1.HookName{v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT}, reg.RegisteredHooks(impl.ID))
+	assert.True(t, reg.HasHooks(v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT))
+
+	reg.UnloadPlugin(impl.ID)
+	assert.Empty(t, reg.List())
+	assert.False(t, reg.HasHooks(v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT))
+	assert.Nil(t, reg.RegisteredHooks(impl.ID))
+}
+
+// Test_ParseAsyncHooks tests that only the observation hooks can run async.
+func Test_ParseAsyncHooks(t *testing.T) {
+	hookNames, err := ParseAsyncHooks([]string{"HOOK_NAME_ON_OPENED", "HOOK_NAME_ON_TICK"})
+	assert.Nil(t, err)
+	assert.Equal(t,
+		[]v1.HookName{v1.HookName_HOOK_NAME_ON_OPENED, v1.HookName_HOOK_NAME_ON_TICK}, hookNames)
+
+	_, err = ParseAsyncHooks([]string{"HOOK_NAME_ON_UNKNOWN"})
+	assert.ErrorIs(t, err, gerr.ErrValidationFailed)
+
+	_, err = ParseAsyncHooks([]string{"HOOK_NAME_ON_TRAFFIC_FROM_CLIENT"})
+	assert.ErrorIs(t, err, gerr.ErrValidationFailed)
+}
+
+// Test_PluginRegistry_RunAsync tests that the async hooks are run in parallel in the
+// background, that the calls are dropped if the queue is full and that Shutdown runs
+// the queued calls.
+func Test_PluginRegistry_RunAsync(t *testing.T) {
+	reg := NewPluginRegistry(t)
+	reg.AsyncHooks = []v1.HookName{v1.HookName_HOOK_NAME_ON_OPENED}
+	reg.AsyncWorkers = 1
+	reg.AsyncQueueSize = 1
+	reg.startAsyncWorkers()
+
+	started := make(chan struct{}, 4)
+	release := make(chan struct{})
+	var calls atomic.Int32
+	for priority := range 2 {
+		reg.AddHook(v1.HookName_HOOK_NAME_ON_OPENED, sdkPlugin.Priority(priority), func(
+			_ context.Context,
+			args *v1.Struct,
+			_ ...grpc.CallOption,
+		) (*v1.Struct, error) {
+			started <- struct{}{}
+			<-release
+			calls.Add(1)
+			return args, nil
+		})
+	}
+
+	args := map[string]interface{}{"client": "localhost:5432"}
+	result, err := reg.Run(context.Background(), args, v1.HookName_HOOK_NAME_ON_OPENED)
+	assert.Nil(t, err)
+	assert.Equal(t, args, result)
+
+	// Both hooks are called before either of them returns.
+	for range 2 {
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Fatal("the async hooks weren't called in parallel")
+		}
+	}
+
+	// The only worker is busy, so the next call is queued and the one after it is dropped.
+	dropped := testutil.ToFloat64(metrics.PluginAsyncHooksDropped)
+	_, err = reg.Run(context.Background(), args, v1.HookName_HOOK_NAME_ON_OPENED)
+	assert.Nil(t, err)
+	_, err = reg.Run(context.Background(), args, v1.HookName_HOOK_NAME_ON_OPENED)
+	assert.Nil(t, err)
+	assert.Equal(t, dropped+1, testutil.ToFloat64(metrics.PluginAsyncHooksDropped))
+
+	close(release)
+	reg.Shutdown()
+	assert.Equal(t, int32(4), calls.Load())
+
+	// The calls are dropped after the shutdown.
+	_, err = reg.Run(context.Background(), args, v1.HookName_HOOK_NAME_ON_OPENED)
+	assert.Nil(t, err)
+	assert.Equal(t, dropped+2, testutil.ToFloat64(metrics.PluginAsyncHooksDropped))
+}
+
+// Test_PluginRegistry_CircuitBreaker tests the hook timeouts, the circuit breaker and
+// the failure modes of the plugins.
+func Test_PluginRegistry_CircuitBreaker(t *testing.T) {
+	reg := NewPluginRegistry(t)
+
+	failing, err := newPluginSettings(config.Plugin{
+		Name: "failing",
+		HookTimeouts: map[string]time.Duration{
+			"HOOK_NAME_ON_TRAFFIC_FROM_CLIENT": 10 * time.Millisecond,
+		},
+		CircuitBreaker: config.CircuitBreaker{FailureThreshold: 2, CoolDown: 50 * time.Millisecond},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, config.FailOpen, failing.failureMode)
+	reg.settings[0] = failing
+
+	var fail atomic.Bool
+	fail.Store(true)
+	var calls atomic.Int32
+	reg.AddHook(v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT, 0, func(
+		ctx context.Context,
+		args *v1.Struct,
+		_ ...grpc.CallOption,
+	) (*v1.Struct, error) {
+		calls.Add(1)
+		if fail.Load() {
+			// The hook times out.
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		args.Fields["failing"] = v1.NewBoolValue(true)
+		return args, nil
+	})
+	reg.AddHook(v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT, 1, func(
+		_ context.Context,
+		args *v1.Struct,
+		_ ...grpc.CallOption,
+	) (*v1.Struct, error) {
+		args.Fields["next"] = v1.NewBoolValue(true)
+		return args, nil
+	})
+
+	run := func() map[string]any {
+		result, err := reg.Run(
+			context.Background(), map[string]any{"request": "query"},
+			v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT)
+		assert.Nil(t, err)
+		return result
+	}
+
+	// The plugin fails open, so the args are passed to the next hook.
+	for range 2 {
+		result := run()
+		assert.Equal(t, "query", result["request"])
+		assert.Equal(t, true, result["next"])
+		assert.NotContains(t, result, "failing")
+	}
+	state, failures := failing.breaker.State()
+	assert.Equal(t, BreakerOpen, state)
+	assert.Equal(t, 2, failures)
+
+	// The hook is skipped while the breaker is open, and it isn't removed.
+	run()
+	assert.Equal(t, int32(2), calls.Load())
+	assert.True(t, reg.HasHooks(v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT))
+
+	// The hook is tried again after the cool-down and the breaker is closed.
+	time.Sleep(50 * time.Millisecond)
+	state, _ = failing.breaker.State()
+	assert.Equal(t, BreakerHalfOpen, state)
+	fail.Store(false)
+	result := run()
+	assert.Equal(t, true, result["failing"])
+	assert.Equal(t, true, result["next"])
+	state, failures = failing.breaker.State()
+	assert.Equal(t, BreakerClosed, state)
+	assert.Equal(t, 0, failures)
+
+	// The traffic is terminated if the plugin fails closed, and the callers
+	// receive an error, so that they close the connection.
+	fail.Store(true)
+	failing.failureMode = config.FailClosed
+	result, hookErr := reg.Run(
+		context.Background(), map[string]any{"request": "query"},
+		v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT)
+	assert.ErrorIs(t, hookErr, gerr.ErrPluginFailedClosed)
+	assert.Equal(t, true, result[sdkAct.Terminal])
+	assert.NotContains(t, result, "next")
+
+	// The hooks that have no traffic to terminate always fail open.
+	reg.AddHook(v1.HookName_HOOK_NAME_ON_TICK, 0, func(
+		_ context.Context,
+		_ *v1.Struct,
+		_ ...grpc.CallOption,
+	) (*v1.Struct, error) {
+		return nil, errors.New("failed") //nolint:goerr113
+	})
+	reg.AddHook(v1.HookName_HOOK_NAME_ON_TICK, 1, func(
+		_ context.Context,
+		args *v1.Struct,
+		_ ...grpc.CallOption,
+	) (*v1.Struct, error) {
+		args.Fields["next"] = v1.NewBoolValue(true)
+		return args, nil
+	})
+	result, hookErr = reg.Run(
+		context.Background(), map[string]any{"tick": true}, v1.HookName_HOOK_NAME_ON_TICK)
+	assert.Nil(t, hookErr)
+	assert.Equal(t, true, result["next"])
+	assert.NotContains(t, result, sdkAct.Terminal)
+
+	_, err = newPluginSettings(config.Plugin{Name: "invalid", FailureMode: "sometimes"})
+	assert.NotNil(t, err)
+	_, err = newPluginSettings(config.Plugin{
+		Name: "invalid", HookTimeouts: map[string]time.Duration{"HOOK_NAME_ON_UNKNOWN": time.Second},
+	})
+	assert.NotNil(t, err)
+}
+
+// Test_PluginRegistry_HookPriorities tests that the hooks of a plugin can be ordered
+// differently from its other hooks, and that the hook chains are in the order they run.
+func Test_PluginRegistry_HookPriorities(t *testing.T) {
+	reg := NewPluginRegistry(t)
+
+	first := &Plugin{
+		ID:       sdkPlugin.Identifier{Name: "first"},
+		Priority: 1000,
+		Hooks: []v1.HookName{
+			v1.HookName_HOOK_NAME_ON_NEW_LOGGER,
+			v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT,
+		},
+	}
+	second := &Plugin{
+		ID:       sdkPlugin.Identifier{Name: "second"},
+		Priority: 1001,
+		Hooks:    []v1.HookName{v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT},
+	}
+	reg.Add(first)
+	reg.Add(second)
+
+	settings, err := newPluginSettings(config.Plugin{
+		Name:           "first",
+		HookPriorities: map[string]uint{"HOOK_NAME_ON_TRAFFIC_FROM_CLIENT": 1100},
+	})
+	assert.Nil(t, err)
+	reg.settings[first.Priority] = settings
+	reg.settings[1100] = settings
+
+	var order []string
+	hook := func(name string) sdkPlugin.Method {
+		return func(_ context.Context, args *v1.Struct, _ ...grpc.CallOption) (*v1.Struct, error) {
+			order = append(order, name)
+			return args, nil
+		}
+	}
+	for _, plugin := range []*Plugin{first, second} {
+		for _, hookName := range plugin.Hooks {
+			reg.AddHook(hookName, reg.hookPriority(plugin, hookName), hook(plugin.ID.Name))
+		}
+	}
+
+	_, runErr := reg.Run(
+		context.Background(), map[string]any{}, v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT)
+	assert.Nil(t, runErr)
+	assert.Equal(t, []string{"second", "first"}, order)
+
+	chains := reg.HookChains()
+	assert.Equal(t, []ChainedHook{{Plugin: "first", Priority: 1000}},
+		chains[v1.HookName_HOOK_NAME_ON_NEW_LOGGER])
+	assert.Equal(t, []ChainedHook{{Plugin: "second", Priority: 1001}, {Plugin: "first", Priority: 1100}},
+		chains[v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT])
+	assert.ElementsMatch(t, first.Hooks, reg.RegisteredHooks(first.ID))
+
+	reg.UnregisterHooks(first.ID, v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT)
+	assert.Equal(t, []ChainedHook{{Plugin: "second", Priority: 1001}},
+		reg.HookChains()[v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT])
+	assert.Equal(t, []v1.HookName{v1.HookName_HOOK_NAME_ON_NEW_LOGGER}, reg.RegisteredHooks(first.ID))
+
+	reg.Remove(first.ID)
+	assert.NotContains(t, reg.settings, sdkPlugin.Priority(1000))
+	assert.NotContains(t, reg.settings, sdkPlugin.Priority(1100))
+}
+
 func BenchmarkHookRun(b *testing.B) {
 	cfg := logging.LoggerConfig{
 		Output:            []config.LogOutput{config.Console},
@@ -184,3 +522,268 @@ func BenchmarkHookRun(b *testing.B) {
 		)
 	}
 }
+
+// Test_sortByRequirements tests that the plugins are sorted by their requirements,
+// and that the dependency cycles are detected.
+func Test_sortByRequirements(t *testing.T) {
+	newPlugin := func(name string, requires ...string) *Plugin {
+		plugin := &Plugin{ID: sdkPlugin.Identifier{Name: name}}
+		for _, req := range requires {
+			plugin.Requires = append(plugin.Requires, sdkPlugin.Identifier{Name: req})
+		}
+		return plugin
+	}
+	names := func(plugins []*Plugin) []string {
+		result := make([]string, 0, len(plugins))
+		for _, plugin := range plugins {
+			result = append(result, plugin.ID.Name)
+		}
+		return result
+	}
+
+	sorted, cycles := sortByRequirements([]*Plugin{
+		newPlugin("cache", "auth"),
+		newPlugin("logger"),
+		newPlugin("auth", "logger", "missing"),
+		newPlugin("metrics"),
+	})
+	assert.Equal(t, []string{"logger", "auth", "cache", "metrics"}, names(sorted))
+	assert.Empty(t, cycles)
+
+	sorted, cycles = sortByRequirements([]*Plugin{
+		newPlugin("a", "b"),
+		newPlugin("b", "c"),
+		newPlugin("c", "b"),
+		newPlugin("d"),
+	})
+	assert.Equal(t, []string{"d", "a", "b", "c"}, names(sorted))
+	assert.Equal(t, map[string][]string{
+		"b": {"b", "c", "b"},
+		"c": {"b", "c", "b"},
+	}, cycles)
+}
+
+// Test_PluginRegistry_unmetRequirements tests the semver ranges of the requirements.
+func Test_PluginRegistry_unmetRequirements(t *testing.T) {
+	reg := NewPluginRegistry(t)
+	reg.Add(&Plugin{ID: sdkPlugin.Identifier{
+		Name:      "logger",
+		Version:   "1.4.2",
+		RemoteURL: "github.com/remote/logger",
+	}})
+
+	tests := []struct {
+		version   string
+		remoteURL string
+		reasons   []string
+	}{
+		{version: "", reasons: nil},
+		{version: "1.0.0", reasons: nil},
+		{version: "^1.2", reasons: nil},
+		{version: ">= 1.0, < 1.4", reasons: []string{
+			`requires "logger" >= 1.0, < 1.4, but the version is 1.4.2`,
+		}},
+		{version: "2.0.0", reasons: []string{
+			`requires "logger" 2.0.0, but the version is 1.4.2`,
+		}},
+		{version: "1.x", remoteURL: "github.com/other/logger", reasons: []string{
+			`requires "logger" from github.com/other/logger, but it is from github.com/remote/logger`,
+		}},
+	}
+	for _, test := range tests {
+		plugin := &Plugin{
+			ID: sdkPlugin.Identifier{Name: "cache"},
+			Requires: []sdkPlugin.Identifier{
+				{Name: "logger", Version: test.version, RemoteURL: test.remoteURL},
+			},
+		}
+		assert.Equal(t, test.reasons, reg.unmetRequirements(plugin, nil), test.version)
+	}
+
+	plugin := &Plugin{
+		ID: sdkPlugin.Identifier{Name: "cache"},
+		Requires: []sdkPlugin.Identifier{
+			{Name: "auth"},
+			{Name: "metrics"},
+		},
+	}
+	assert.Equal(t, []string{
+		`requires "auth", which is disabled`,
+		`requires "metrics", which isn't loaded`,
+	}, reg.unmetRequirements(plugin, map[string]string{"auth": "is disabled"}))
+
+	assert.True(t, reg.Exists("logger", "~1.4", "github.com/remote/logger"))
+	assert.False(t, reg.Exists("logger", "1.5.0", "github.com/remote/logger"))
+}
+
+// testBuiltinPlugin is a built-in plugin that appends its name to the query.
+type testBuiltinPlugin struct {
+	v1.UnimplementedGatewayDPluginServiceServer
+	name string
+}
+
+func (p *testBuiltinPlugin) GetPluginConfig(context.Context, *v1.Struct) (*v1.Struct, error) {
+	return v1.NewStruct(map[string]interface{}{
+		"id": map[string]interface{}{
+			"name":    p.name,
+			"version": "1.0.0",
+		},
+		"hooks": []interface{}{int32(v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT)},
+	})
+}
+
+func (p *testBuiltinPlugin) OnTrafficFromClient(
+	_ context.Context, args *v1.Struct,
+) (*v1.Struct, error) {
+	args.Fields["query"] = v1.NewStringValue(args.Fields["query"].GetStringValue() + p.name)
+	return args, nil
+}
+
+// Test_PluginRegistry_BuiltinPlugins tests that the built-in plugins are loaded
+// before the plugins in the config and that their hooks are called in-process.
+func Test_PluginRegistry_BuiltinPlugins(t *testing.T) {
+	reg := NewPluginRegistry(t)
+	reg.BuiltinPlugins = []BuiltinPlugin{
+		{Priority: 20, Impl: &testBuiltinPlugin{name: "b"}},
+		{Priority: 10, Impl: &testBuiltinPlugin{name: "a"}},
+		// The priorities from 1000 are used by the plugins in the config.
+		{Priority: config.PluginPriorityStart, Impl: &testBuiltinPlugin{name: "c"}},
+		{Priority: 30},
+	}
+
+	report := reg.LoadPlugins(context.Background(), nil, time.Second)
+	assert.Empty(t, report)
+	assert.Equal(t, 2, reg.Size())
+
+	plugin := reg.Get(sdkPlugin.Identifier{Name: "a", Version: "1.0.0"})
+	if assert.NotNil(t, plugin) {
+		assert.True(t, plugin.IsBuiltin())
+		assert.Equal(t, sdkPlugin.Priority(10), plugin.Priority)
+		assert.Nil(t, plugin.Ping())
+	}
+	assert.Len(t, reg.Hooks()[v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT], 2)
+
+	result, err := reg.Run(
+		context.Background(),
+		map[string]any{"query": "q"},
+		v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT)
+	assert.Nil(t, err)
+	assert.Equal(t, "qab", result["query"])
+
+	reg.Shutdown()
+	assert.Empty(t, reg.List())
+}
+
+// Test_PluginRegistry_ExpressionHooks tests that the expression hooks return signals
+// and modify the fields of the payload.
+func Test_PluginRegistry_ExpressionHooks(t *testing.T) {
+	reg := NewPluginRegistry(t)
+	err := reg.LoadExpressionHooks([]config.ExpressionHook{
+		{
+			Name:       "block-drop",
+			Hook:       "HOOK_NAME_ON_TRAFFIC_FROM_CLIENT",
+			Priority:   1000,
+			Expression: `query matches "(?i)^drop" ? [terminate()] : nil`,
+		},
+		{
+			Name:     "internal",
+			Hook:     "HOOK_NAME_ON_TRAFFIC_FROM_CLIENT",
+			Priority: 1001,
+			Expression: `inSubnet(payload.client.remote, "10.0.0.0/8") ? ` +
+				`{"fields": {"internal": true}, "signals": [log("info", "internal client")]} : nil`,
+		},
+		{Name: "unknown", Hook: "HOOK_NAME_ON_UNKNOWN", Priority: 1002, Expression: "nil"},
+		{Name: "invalid", Hook: "HOOK_NAME_ON_OPENED", Priority: 1003, Expression: "unknown("},
+		{Name: "taken", Hook: "HOOK_NAME_ON_OPENED", Priority: 1000, Expression: "nil"},
+	})
+	assert.ErrorIs(t, err, gerr.ErrValidationFailed)
+	assert.Contains(t, err.Error(), `"unknown": unknown hook "HOOK_NAME_ON_UNKNOWN"`)
+	assert.Contains(t, err.Error(), `"invalid": invalid expression`)
+	assert.Contains(t, err.Error(), `"taken": the priority 1000 is taken by "block-drop"`)
+	assert.Len(t, reg.Hooks()[v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT], 2)
+	assert.Empty(t, reg.Hooks()[v1.HookName_HOOK_NAME_ON_OPENED])
+
+	// query returns a PostgreSQL simple query message with the query.
+	query := func(query string) []byte {
+		size := 4 + len(query) + 1
+		message := []byte{'Q', byte(size >> 24), byte(size >> 16), byte(size >> 8), byte(size)}
+		return append(append(message, query...), 0)
+	}
+
+	result, runErr := reg.Run(context.Background(), map[string]any{
+		"client":  map[string]any{"remote": "10.1.2.3:5432"},
+		"request": query("SELECT 1"),
+	}, v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT)
+	assert.Nil(t, runErr)
+	assert.Equal(t, true, result["internal"])
+	assert.Equal(t, query("SELECT 1"), result["request"])
+	assert.Nil(t, result[sdkAct.Terminal])
+
+	result, runErr = reg.Run(context.Background(), map[string]any{
+		"client":  map[string]any{"remote": "192.168.1.2:5432"},
+		"request": query("DROP TABLE users"),
+	}, v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT)
+	assert.Nil(t, runErr)
+	assert.Equal(t, true, result[sdkAct.Terminal])
+	assert.Nil(t, result["internal"])
+
+	chains := reg.HookChains()[v1.HookName_HOOK_NAME_ON_TRAFFIC_FROM_CLIENT]
+	assert.Equal(t, []ChainedHook{
+		{Plugin: "block-drop", Priority: 1000},
+		{Plugin: "internal", Priority: 1001},
+	}, chains)
+
+	assert.True(t, inSubnet("[::ffff:10.0.0.1]:80", "10.0.0.0/8"))
+	assert.False(t, inSubnet("localhost:80", "10.0.0.0/8"))
+}
+
+// Test_PluginRegistry_verifySignature tests that the signatures of the plugins are
+// verified according to the signature policy.
+func Test_PluginRegistry_verifySignature(t *testing.T) {
+	reg := NewPluginRegistry(t)
+	keyID := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	data := []byte("plugin binary")
+	digest := sha256.Sum256(data)
+	binary := filepath.Join(t.TempDir(), "plugin")
+	require.NoError(t, os.WriteFile(binary, data, 0o600))
+	pCfg := config.Plugin{
+		Name:      "plugin",
+		LocalPath: binary,
+		Checksum:  hex.EncodeToString(digest[:]),
+	}
+
+	// The plugins that aren't signed are only rejected if the signatures are required.
+	reg.SignaturePolicy = config.SignaturesOptional
+	assert.NoError(t, reg.verifySignature(pCfg))
+	reg.SignaturePolicy = config.SignaturesRequired
+	assert.ErrorContains(t, reg.verifySignature(pCfg), "isn't signed")
+
+	require.NoError(t, os.WriteFile(
+		binary+".minisig", minisign(privateKey, keyID, data, true), 0o600))
+	assert.ErrorContains(t, reg.verifySignature(pCfg), "no trusted keys")
+
+	reg.TrustedKeys, err = ParseTrustedKeys([]string{minisignKey(publicKey, keyID)})
+	require.NoError(t, err)
+	assert.NoError(t, reg.verifySignature(pCfg))
+
+	// The checksum in the config must match the signed binary.
+	changed := pCfg
+	changed.Checksum = strings.Repeat("0", sha256.Size*2)
+	assert.ErrorContains(t, reg.verifySignature(changed), "checksum")
+
+	// The binary can't be replaced without a new signature.
+	require.NoError(t, os.WriteFile(binary, []byte("other binary"), 0o600))
+	assert.ErrorContains(t, reg.verifySignature(pCfg), "invalid signature")
+	reg.SignaturePolicy = config.SignaturesOptional
+	assert.Error(t, reg.verifySignature(pCfg))
+	reg.SignaturePolicy = config.SignaturesDisabled
+	assert.NoError(t, reg.verifySignature(pCfg))
+
+	// The signature in the config must exist.
+	reg.SignaturePolicy = config.SignaturesOptional
+	changed.Signature = binary + ".missing"
+	assert.ErrorContains(t, reg.verifySignature(changed), "signature file not found")
+}
@@ -0,0 +1,269 @@
+package plugin
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/gatewayd-io/gatewayd/config"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	minisignKeyIDSize     = 8
+	minisignKeySize       = 2 + minisignKeyIDSize + ed25519.PublicKeySize
+	minisignSignatureSize = 2 + minisignKeyIDSize + ed25519.SignatureSize
+	untrustedComment      = "untrusted comment:"
+	trustedComment        = "trusted comment:"
+)
+
+// SignatureExtensions are the extensions of the detached signatures that are looked up
+// next to the signed files: minisign signatures and cosign-style base64 signatures.
+var SignatureExtensions = []string{".minisig", ".sig"}
+
+// TrustedKey is a public key that verifies the signatures of the plugins. It is either a
+// minisign Ed25519 key, or an ECDSA, Ed25519 or RSA key in the PEM format.
+type TrustedKey struct {
+	// keyID is the ID of a minisign key, which is in its signatures.
+	keyID []byte
+	key   crypto.PublicKey
+}
+
+// ParseTrustedKeys parses the trusted keys, which are minisign public keys, PEM public
+// keys, or the paths of the files that contain them.
+func ParseTrustedKeys(keys []string) ([]TrustedKey, error) {
+	trusted := make([]TrustedKey, 0, len(keys))
+	var errs []error
+	for _, key := range keys {
+		parsed, err := parseTrustedKey(key)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		trusted = append(trusted, *parsed)
+	}
+
+	// The valid keys are returned, even if some keys are invalid.
+	return trusted, errors.Join(errs...)
+}
+
+// parseTrustedKey parses a key, or the key in the file if the key is a path.
+func parseTrustedKey(key string) (*TrustedKey, error) {
+	key = strings.TrimSpace(key)
+	if !strings.HasPrefix(key, "-----BEGIN") && !strings.HasPrefix(key, untrustedComment) {
+		if parsed, err := parseMinisignKey(key); err == nil {
+			return parsed, nil
+		}
+		contents, err := os.ReadFile(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted key %q: %w", key, err)
+		}
+		key = strings.TrimSpace(string(contents))
+	}
+
+	if block, _ := pem.Decode([]byte(key)); block != nil {
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid PEM public key: %w", err)
+		}
+		switch publicKey.(type) {
+		case *ecdsa.PublicKey, ed25519.PublicKey, *rsa.PublicKey:
+			return &TrustedKey{key: publicKey}, nil
+		default:
+			return nil, fmt.Errorf("unsupported public key type %T", publicKey) //nolint:goerr113
+		}
+	}
+
+	return parseMinisignKey(key)
+}
+
+// parseMinisignKey parses a minisign public key, which can have an untrusted comment.
+func parseMinisignKey(key string) (*TrustedKey, error) {
+	lines := strings.Split(strings.TrimSpace(key), "\n")
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[len(lines)-1]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid minisign public key: %w", err)
+	}
+	if len(decoded) != minisignKeySize || string(decoded[:2]) != "Ed" {
+		return nil, errors.New("invalid minisign public key") //nolint:goerr113
+	}
+
+	return &TrustedKey{
+		keyID: decoded[2 : 2+minisignKeyIDSize],
+		key:   ed25519.PublicKey(decoded[2+minisignKeyIDSize:]),
+	}, nil
+}
+
+// FindSignature returns the path of the detached signature of the file, which is the
+// given path, or the path of the file with one of the signature extensions. It returns
+// an empty path if the file isn't signed.
+func FindSignature(filename, signature string) (string, error) {
+	if signature != "" {
+		if _, err := os.Stat(signature); err != nil {
+			return "", fmt.Errorf("signature file not found: %w", err)
+		}
+		return signature, nil
+	}
+
+	for _, ext := range SignatureExtensions {
+		if _, err := os.Stat(filename + ext); err == nil {
+			return filename + ext, nil
+		}
+	}
+	return "", nil
+}
+
+// VerifySignature verifies the detached signature of the data with the trusted keys. The
+// signature is either a minisign signature, or a base64-encoded signature of the SHA-256
+// digest of the data, which is what cosign creates for blobs.
+func VerifySignature(data, signature []byte, keys []TrustedKey) error {
+	if len(keys) == 0 {
+		return errors.New("no trusted keys") //nolint:goerr113
+	}
+
+	signature = bytes.TrimSpace(signature)
+	if bytes.HasPrefix(signature, []byte(untrustedComment)) {
+		return verifyMinisign(data, string(signature), keys)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(string(signature))
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	digest := sha256.Sum256(data)
+	for _, trusted := range keys {
+		var valid bool
+		switch key := trusted.key.(type) {
+		case *ecdsa.PublicKey:
+			valid = ecdsa.VerifyASN1(key, digest[:], decoded)
+		case *rsa.PublicKey:
+			valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], decoded) == nil
+		case ed25519.PublicKey:
+			// The minisign keys can only verify minisign signatures.
+			valid = trusted.keyID == nil && ed25519.Verify(key, data, decoded)
+		}
+		if valid {
+			return nil
+		}
+	}
+	return errors.New("the signature isn't made by a trusted key") //nolint:goerr113
+}
+
+// verifyMinisign verifies a minisign signature, including its trusted comment.
+func verifyMinisign(data []byte, signature string, keys []TrustedKey) error {
+	lines := strings.Split(signature, "\n")
+	if len(lines) < 4 || !strings.HasPrefix(lines[2], trustedComment) { //nolint:gomnd
+		return errors.New("invalid minisign signature") //nolint:goerr113
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[1]))
+	if err != nil || len(decoded) != minisignSignatureSize {
+		return errors.New("invalid minisign signature") //nolint:goerr113
+	}
+	algorithm := decoded[:2]
+	keyID := decoded[2 : 2+minisignKeyIDSize]
+	sig := decoded[2+minisignKeyIDSize:]
+
+	globalSig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[3]))
+	if err != nil || len(globalSig) != ed25519.SignatureSize {
+		return errors.New("invalid minisign global signature") //nolint:goerr113
+	}
+
+	index := slices.IndexFunc(keys, func(key TrustedKey) bool {
+		return bytes.Equal(key.keyID, keyID)
+	})
+	if index < 0 {
+		// The key IDs are shown like minisign does, as little-endian numbers.
+		id := slices.Clone(keyID)
+		slices.Reverse(id)
+		return fmt.Errorf( //nolint:goerr113
+			"the signature is made by the untrusted key %s", strings.ToUpper(hex.EncodeToString(id)))
+	}
+	publicKey, _ := keys[index].key.(ed25519.PublicKey)
+
+	message := data
+	switch string(algorithm) {
+	case "Ed":
+	case "ED":
+		// The data is hashed before it is signed.
+		digest := blake2b.Sum512(data)
+		message = digest[:]
+	default:
+		return fmt.Errorf("unsupported signature algorithm %q", algorithm) //nolint:goerr113
+	}
+
+	if !ed25519.Verify(publicKey, message, sig) {
+		return errors.New("invalid signature") //nolint:goerr113
+	}
+
+	comment := strings.TrimPrefix(strings.TrimSuffix(lines[2], "\r"), trustedComment+" ")
+	if !ed25519.Verify(publicKey, append(slices.Clone(sig), comment...), globalSig) {
+		return errors.New("invalid trusted comment signature") //nolint:goerr113
+	}
+	return nil
+}
+
+// verifySignature verifies the signature of the plugin binary with the trusted keys,
+// according to the signature policy. The checksum of the signed binary must match the
+// checksum in the config, which is verified again when the plugin is started, so that
+// a binary that is replaced after its signature is verified isn't started.
+func (reg *Registry) verifySignature(pCfg config.Plugin) error {
+	if reg.SignaturePolicy == config.SignaturesDisabled {
+		return nil
+	}
+
+	signatureFile, err := FindSignature(pCfg.LocalPath, pCfg.Signature)
+	if err != nil {
+		return err
+	}
+	if signatureFile == "" {
+		if reg.SignaturePolicy == config.SignaturesRequired {
+			return errors.New("the plugin isn't signed") //nolint:goerr113
+		}
+		reg.Logger.Debug().Str("name", pCfg.Name).Msg("Plugin isn't signed")
+		return nil
+	}
+
+	if len(reg.TrustedKeys) == 0 && reg.SignaturePolicy != config.SignaturesRequired {
+		reg.Logger.Warn().Str("name", pCfg.Name).Msg(
+			"Plugin is signed, but there are no trusted keys to verify its signature")
+		return nil
+	}
+
+	data, err := os.ReadFile(pCfg.LocalPath)
+	if err != nil {
+		return fmt.Errorf("failed to read the plugin: %w", err)
+	}
+	signature, err := os.ReadFile(signatureFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the signature: %w", err)
+	}
+
+	if err := VerifySignature(data, signature, reg.TrustedKeys); err != nil {
+		return fmt.Errorf("%s: %w", signatureFile, err)
+	}
+
+	digest := sha256.Sum256(data)
+	if !strings.EqualFold(hex.EncodeToString(digest[:]), pCfg.Checksum) {
+		return errors.New("the checksum doesn't match the signed plugin") //nolint:goerr113
+	}
+
+	reg.Logger.Debug().Fields(map[string]any{
+		"name":      pCfg.Name,
+		"signature": signatureFile,
+	}).Msg("Verified plugin signature")
+	return nil
+}
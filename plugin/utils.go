@@ -1,11 +1,18 @@
 package plugin
 
 import (
+	"errors"
+	"fmt"
 	"os/exec"
+	"slices"
 	"time"
 
 	sdkAct "github.com/gatewayd-io/gatewayd-plugin-sdk/act"
+	sdkPlugin "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin"
+	v1 "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin/v1"
 	"github.com/gatewayd-io/gatewayd/act"
+	"github.com/gatewayd-io/gatewayd/config"
+	gerr "github.com/gatewayd-io/gatewayd/errors"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cast"
 )
@@ -100,3 +107,96 @@ func applyPolicies(
 
 	return outputs
 }
+
+// Priority returns the priority of the plugin at the given index
+// of the plugins in the config file.
+func Priority(index int) sdkPlugin.Priority {
+	return sdkPlugin.Priority(config.PluginPriorityStart + uint(index))
+}
+
+// PluginPriority returns the priority of the plugin in the config, or the priority of
+// its position in the config file if it isn't set.
+func PluginPriority(pluginConfig config.Plugin, index int) sdkPlugin.Priority {
+	if pluginConfig.Priority > 0 {
+		return sdkPlugin.Priority(pluginConfig.Priority)
+	}
+	return Priority(index)
+}
+
+// HookPriorities returns the priorities of the hooks of the plugin that are overridden
+// in the config.
+func HookPriorities(pluginConfig config.Plugin) (map[v1.HookName]sdkPlugin.Priority, error) {
+	priorities := make(map[v1.HookName]sdkPlugin.Priority, len(pluginConfig.HookPriorities))
+	for name, priority := range pluginConfig.HookPriorities {
+		value, ok := v1.HookName_value[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown hook %q in the hook priorities", name) //nolint:goerr113
+		}
+		priorities[v1.HookName(value)] = sdkPlugin.Priority(priority)
+	}
+	return priorities, nil
+}
+
+// ValidatePriorities checks that the priorities of the plugins, of their hooks and of the
+// expression hooks aren't reserved for the built-in plugins and that each priority is only
+// used by one plugin or expression hook, since the hooks are ordered by their priorities.
+func ValidatePriorities(
+	plugins []config.Plugin, expressionHooks []config.ExpressionHook,
+) *gerr.GatewayDError {
+	var errs []error
+	owners := map[sdkPlugin.Priority]string{}
+	claim := func(name string, priority sdkPlugin.Priority, field string) {
+		if priority < config.PluginPriorityStart {
+			errs = append(errs, fmt.Errorf( //nolint:goerr113
+				"%s of %q is %d, but the priorities below %d are reserved for the built-in plugins",
+				field, name, priority, config.PluginPriorityStart))
+			return
+		}
+		if owner, ok := owners[priority]; ok && owner != name {
+			errs = append(errs, fmt.Errorf( //nolint:goerr113
+				"%s of %q is %d, which is already used by %q", field, name, priority, owner))
+			return
+		}
+		owners[priority] = name
+	}
+
+	for index, pluginConfig := range plugins {
+		claim(pluginConfig.Name, PluginPriority(pluginConfig, index), "the priority")
+
+		hookPriorities, err := HookPriorities(pluginConfig)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%q: %w", pluginConfig.Name, err))
+			continue
+		}
+		// Sort the hooks, so that the errors are always in the same order.
+		hookNames := make([]v1.HookName, 0, len(hookPriorities))
+		for hookName := range hookPriorities {
+			hookNames = append(hookNames, hookName)
+		}
+		slices.Sort(hookNames)
+		for _, hookName := range hookNames {
+			claim(pluginConfig.Name, hookPriorities[hookName], "the priority of "+hookName.String())
+		}
+	}
+
+	for _, hookConfig := range expressionHooks {
+		claim(hookConfig.Name, sdkPlugin.Priority(hookConfig.Priority), "the priority")
+	}
+
+	if len(errs) > 0 {
+		return gerr.ErrValidationFailed.Wrap(errors.Join(errs...))
+	}
+	return nil
+}
+
+// FindPluginConfig returns the config of the plugin with the given name and its
+// priority, which is set in the config or determined by the position of the plugin
+// in the config file.
+func FindPluginConfig(plugins []config.Plugin, name string) (config.Plugin, sdkPlugin.Priority, bool) {
+	for index, pluginConfig := range plugins {
+		if pluginConfig.Name == name {
+			return pluginConfig, PluginPriority(pluginConfig, index), true
+		}
+	}
+	return config.Plugin{}, 0, false
+}
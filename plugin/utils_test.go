@@ -1,15 +1,30 @@
 package plugin
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	sdkAct "github.com/gatewayd-io/gatewayd-plugin-sdk/act"
+	sdkPlugin "github.com/gatewayd-io/gatewayd-plugin-sdk/plugin"
 	"github.com/gatewayd-io/gatewayd/act"
 	"github.com/gatewayd-io/gatewayd/config"
+	gerr "github.com/gatewayd-io/gatewayd/errors"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cast"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
 )
 
 func Test_NewCommand(t *testing.T) {
@@ -21,6 +36,26 @@ func Test_NewCommand(t *testing.T) {
 	assert.Equal(t, []string{"test=123"}, cmd.Env)
 }
 
+// Test_newIsolation tests that the isolation settings of the plugins are validated.
+func Test_newIsolation(t *testing.T) {
+	iso, err := newIsolation("test", config.PluginIsolation{})
+	assert.NoError(t, err)
+	assert.False(t, iso.skipHostEnv())
+
+	_, err = newIsolation("test", config.PluginIsolation{MemoryLimit: "a lot"})
+	assert.EqualError(t, err, `invalid memory limit "a lot"`)
+	_, err = newIsolation("test", config.PluginIsolation{CPULimit: 0.5})
+	assert.EqualError(t, err, "the CPU limit requires a cgroup")
+	_, err = newIsolation("test", config.PluginIsolation{User: "gatewayd-unknown-user"})
+	assert.ErrorContains(t, err, `unknown user "gatewayd-unknown-user"`)
+
+	assert.Equal(t,
+		[]string{"PATH=/bin", "PGHOST=localhost", "PGPORT=5432"},
+		filterEnv(
+			[]string{"PATH=/bin", "PGHOST=localhost", "PGPORT=5432", "SECRET=1", "PATHS=x"},
+			[]string{"PATH", "PG*"}))
+}
+
 // Test_castToPrimitiveTypes tests the CastToPrimitiveTypes function.
 func Test_castToPrimitiveTypes(t *testing.T) {
 	actual := map[string]interface{}{
@@ -114,3 +149,121 @@ func Test_applyPolicies(t *testing.T) {
 	assert.Nil(t, gerr)
 	assert.True(t, cast.ToBool(result))
 }
+
+// Test_ValidatePriorities tests that the priorities of the plugins and of their hooks
+// can't be reserved or used by more than one plugin.
+func Test_ValidatePriorities(t *testing.T) {
+	plugins := []config.Plugin{
+		{Name: "first"},
+		{Name: "second", Priority: 1100, HookPriorities: map[string]uint{
+			"HOOK_NAME_ON_TRAFFIC_FROM_CLIENT": 900,
+		}},
+		{Name: "third", HookPriorities: map[string]uint{
+			"HOOK_NAME_ON_TRAFFIC_FROM_CLIENT": 1100,
+			"HOOK_NAME_ON_UNKNOWN":             1200,
+		}},
+		{Name: "fourth", Priority: 1000},
+	}
+	assert.Equal(t, sdkPlugin.Priority(1000), PluginPriority(plugins[0], 0))
+	assert.Equal(t, sdkPlugin.Priority(1100), PluginPriority(plugins[1], 1))
+	assert.Equal(t, sdkPlugin.Priority(1002), PluginPriority(plugins[2], 2))
+
+	err := ValidatePriorities(plugins, nil)
+	assert.ErrorIs(t, err, gerr.ErrValidationFailed)
+	assert.Contains(t, err.Error(),
+		`the priority of HOOK_NAME_ON_TRAFFIC_FROM_CLIENT of "second" is 900, `+
+			"but the priorities below 1000 are reserved for the built-in plugins")
+	assert.Contains(t, err.Error(), `"third": unknown hook "HOOK_NAME_ON_UNKNOWN"`)
+	assert.Contains(t, err.Error(), `the priority of "fourth" is 1000, which is already used by "first"`)
+
+	plugins = []config.Plugin{
+		{Name: "first", Priority: 1001},
+		{Name: "second", Priority: 1000, HookPriorities: map[string]uint{
+			"HOOK_NAME_ON_TRAFFIC_FROM_CLIENT": 1002,
+			"HOOK_NAME_ON_TRAFFIC_FROM_SERVER": 1002,
+		}},
+	}
+	assert.Nil(t, ValidatePriorities(plugins, nil))
+
+	plugins[0].HookPriorities = map[string]uint{"HOOK_NAME_ON_OPENED": 1002}
+	err = ValidatePriorities(plugins, nil)
+	assert.ErrorIs(t, err, gerr.ErrValidationFailed)
+	assert.Contains(t, err.Error(), `which is already used by "first"`)
+
+	err = ValidatePriorities(plugins[1:], []config.ExpressionHook{{Name: "block", Priority: 1002}})
+	assert.ErrorIs(t, err, gerr.ErrValidationFailed)
+	assert.Contains(t, err.Error(), `the priority of "block" is 1002, which is already used by "second"`)
+}
+
+// minisignKey returns the minisign public key of the Ed25519 key.
+func minisignKey(publicKey ed25519.PublicKey, keyID []byte) string {
+	key := append(append([]byte("Ed"), keyID...), publicKey...)
+	return "untrusted comment: minisign public key\n" + base64.StdEncoding.EncodeToString(key)
+}
+
+// minisign signs the data like minisign does, with or without hashing the data first.
+func minisign(privateKey ed25519.PrivateKey, keyID, data []byte, prehashed bool) []byte {
+	algorithm, message := "Ed", data
+	if prehashed {
+		digest := blake2b.Sum512(data)
+		algorithm, message = "ED", digest[:]
+	}
+	sig := ed25519.Sign(privateKey, message)
+	comment := "timestamp:1700000000\tfile:plugin"
+	globalSig := ed25519.Sign(privateKey, append(append([]byte{}, sig...), comment...))
+
+	return []byte("untrusted comment: signature from minisign secret key\n" +
+		base64.StdEncoding.EncodeToString(append(append([]byte(algorithm), keyID...), sig...)) +
+		"\ntrusted comment: " + comment + "\n" +
+		base64.StdEncoding.EncodeToString(globalSig) + "\n")
+}
+
+// Test_VerifySignature tests that the minisign and the cosign-style signatures are
+// verified with the trusted keys.
+func Test_VerifySignature(t *testing.T) {
+	data := []byte("plugin binary")
+	keyID := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&ecdsaKey.PublicKey)
+	require.NoError(t, err)
+	// The PEM key is read from the file.
+	pemFile := filepath.Join(t.TempDir(), "cosign.pub")
+	require.NoError(t, os.WriteFile(
+		pemFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+	keys, err := ParseTrustedKeys([]string{minisignKey(publicKey, keyID), pemFile})
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	_, err = ParseTrustedKeys([]string{"invalid"})
+	assert.Error(t, err)
+
+	// Minisign signatures, with and without hashing the data.
+	assert.NoError(t, VerifySignature(data, minisign(privateKey, keyID, data, false), keys))
+	assert.NoError(t, VerifySignature(data, minisign(privateKey, keyID, data, true), keys))
+	assert.Error(t, VerifySignature([]byte("other"), minisign(privateKey, keyID, data, true), keys))
+	assert.ErrorContains(t,
+		VerifySignature(data, minisign(privateKey, []byte("otherkey"), data, true), keys),
+		"untrusted key 79656B726568746F")
+	assert.Error(t, VerifySignature(data, minisign(privateKey, keyID, data, true), nil))
+
+	// The trusted comment is signed.
+	tampered := strings.Replace(
+		string(minisign(privateKey, keyID, data, true)), "file:plugin", "file:other", 1)
+	assert.ErrorContains(t, VerifySignature(data, []byte(tampered), keys), "trusted comment")
+
+	// Cosign-style signatures of the SHA-256 digest.
+	digest := sha256.Sum256(data)
+	sig, err := ecdsa.SignASN1(rand.Reader, ecdsaKey, digest[:])
+	require.NoError(t, err)
+	encoded := []byte(base64.StdEncoding.EncodeToString(sig))
+	assert.NoError(t, VerifySignature(data, encoded, keys))
+	assert.Error(t, VerifySignature([]byte("other"), encoded, keys))
+	// The minisign keys don't verify the raw signatures.
+	raw := []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, data)))
+	assert.Error(t, VerifySignature(data, raw, keys))
+}
@@ -3,6 +3,7 @@ package pool
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/gatewayd-io/gatewayd/config"
 	gerr "github.com/gatewayd-io/gatewayd/errors"
@@ -22,11 +23,12 @@ type IPool interface {
 	Size() int
 	Clear()
 	Cap() int
+	Resize(cap int)
 }
 
 type Pool struct {
 	pool sync.Map
-	cap  int
+	cap  *atomic.Int64
 	ctx  context.Context //nolint:containedctx
 }
 
@@ -53,7 +55,7 @@ func (p *Pool) Pool() *sync.Map {
 func (p *Pool) Put(key, value interface{}) *gerr.GatewayDError {
 	_, span := otel.Tracer(config.TracerName).Start(p.ctx, "Put")
 	defer span.End()
-	if p.cap > 0 && p.Size() >= p.cap {
+	if p.Cap() > 0 && p.Size() >= p.Cap() {
 		span.RecordError(gerr.ErrPoolExhausted)
 		return gerr.ErrPoolExhausted
 	}
@@ -82,7 +84,7 @@ func (p *Pool) Get(key interface{}) interface{} {
 func (p *Pool) GetOrPut(key, value interface{}) (interface{}, bool, *gerr.GatewayDError) {
 	_, span := otel.Tracer(config.TracerName).Start(p.ctx, "GetOrPut")
 	defer span.End()
-	if p.cap > 0 && p.Size() >= p.cap {
+	if p.Cap() > 0 && p.Size() >= p.Cap() {
 		span.RecordError(gerr.ErrPoolExhausted)
 		return nil, false, gerr.ErrPoolExhausted
 	}
@@ -145,7 +147,17 @@ func (p *Pool) Clear() {
 func (p *Pool) Cap() int {
 	_, span := otel.Tracer(config.TracerName).Start(p.ctx, "Cap")
 	defer span.End()
-	return p.cap
+	return int(p.cap.Load())
+}
+
+// Resize changes the capacity of the pool. The pairs that exceed the new capacity
+// are kept, but no new pairs are added until the pool fits in the capacity.
+//
+//nolint:predeclared
+func (p *Pool) Resize(cap int) {
+	_, span := otel.Tracer(config.TracerName).Start(p.ctx, "Resize")
+	defer span.End()
+	p.cap.Store(int64(cap))
 }
 
 // NewPool creates a new pool with the given capacity.
@@ -155,9 +167,12 @@ func NewPool(ctx context.Context, cap int) *Pool {
 	poolCtx, span := otel.Tracer(config.TracerName).Start(ctx, "NewPool")
 	defer span.End()
 
-	return &Pool{
+	p := &Pool{
 		pool: sync.Map{},
-		cap:  cap,
+		cap:  &atomic.Int64{},
 		ctx:  poolCtx,
 	}
+	p.cap.Store(int64(cap))
+
+	return p
 }
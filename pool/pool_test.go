@@ -225,6 +225,28 @@ func TestPool_Cap(t *testing.T) {
 	assert.Equal(t, 1, pool.Cap())
 }
 
+// TestPool_Resize tests the Resize function.
+func TestPool_Resize(t *testing.T) {
+	pool := NewPool(context.Background(), 1)
+	defer pool.Clear()
+	assert.Nil(t, pool.Put("client1.ID", "client1"))
+	assert.NotNil(t, pool.Put("client2.ID", "client2"))
+
+	// Growing the pool makes room for more pairs.
+	pool.Resize(2)
+	assert.Equal(t, 2, pool.Cap())
+	assert.Nil(t, pool.Put("client2.ID", "client2"))
+	assert.Equal(t, 2, pool.Size())
+
+	// Shrinking the pool keeps the pairs, but no more pairs are added.
+	pool.Resize(1)
+	assert.Equal(t, 1, pool.Cap())
+	assert.Equal(t, 2, pool.Size())
+	pool.Pop("client2.ID")
+	assert.NotNil(t, pool.Put("client2.ID", "client2"))
+	assert.Equal(t, 1, pool.Size())
+}
+
 func BenchmarkNewPool(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		NewPool(context.Background(), config.EmptyPoolCapacity)